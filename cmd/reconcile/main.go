@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/repository"
+	postgres "ticket-booking-app-backend/internal/infrastructure/drivers/postgres/connection"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Recomputes events.tickets_sold from the reserved and paid tickets to repair
+// counters that drifted before capacity was released consistently.
+func main() {
+	db := postgres.NewDatabase()
+	if db == nil {
+		logrus.Fatal("failed to initialize database connection")
+	}
+
+	repos := repository.NewRepositories(db.Conn)
+
+	fixed, err := repos.Events.RecalculateTicketsSold(context.Background())
+	if err != nil {
+		logrus.Fatalf("failed to reconcile tickets sold: %v", err)
+	}
+
+	logrus.Infof("Reconciled tickets sold, %d counters corrected", fixed)
+}
@@ -16,6 +16,8 @@ import (
 	"ticket-booking-app-backend/internal/infrastructure/configs"
 	postgres "ticket-booking-app-backend/internal/infrastructure/drivers/postgres/connection"
 	infrastructure "ticket-booking-app-backend/internal/infrastructure/http"
+	"ticket-booking-app-backend/internal/infrastructure/mail"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
 	"ticket-booking-app-backend/internal/presentation/middleware"
 
 	"github.com/sirupsen/logrus"
@@ -43,18 +45,50 @@ func Run(configPath string) {
 		return
 	}
 
-	jwt, err := helpers.NewJwt()
+	jwt, err := helpers.NewJwt(cfg.Auth.JWT.RefreshTokenTTL)
 	if err != nil {
 		logrus.Error(err)
 		return
 	}
 
+	paymentWebhookSecret, err := helpers.GetEnv("PAYMENT_WEBHOOK_SECRET")
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+	// Anyone could sign callbacks with an empty secret
+	if paymentWebhookSecret == "" {
+		logrus.Error("PAYMENT_WEBHOOK_SECRET can't be empty")
+		return
+	}
+
+	// Only the in-process provider is available for now, it calls our own webhook.
+	// It never charges anyone, so production refuses to start with it.
+	if cfg.Payments.Provider != configs.PaymentProviderFake || cfg.Environment == configs.Prod {
+		logrus.Errorf("payment provider %q isn't available in the %q environment", cfg.Payments.Provider, cfg.Environment)
+		return
+	}
+	paymentProvider := payments.NewFakeProvider()
+	paymentProvider.SetWebhook("http://localhost:"+cfg.HTTP.Port+"/api/v1/payments/webhook", paymentWebhookSecret)
+
+	// Mail goes through SMTP when a server is configured, otherwise it's written to a file
+	var mailer mail.Mailer
+	if cfg.Mail.SMTP.Host != "" {
+		mailer = mail.NewSMTPMailer(cfg.Mail.SMTP.Host, cfg.Mail.SMTP.Port, cfg.Mail.SMTP.Username, cfg.Mail.SMTP.Password, cfg.Mail.From)
+	} else {
+		mailer = mail.NewFileMailer(cfg.Mail.File, cfg.Mail.From)
+	}
+
 	// Initializing repositories
 	repos := repository.NewRepositories(db.Conn)
 
 	// Initializing services
-	services := service.NewServices(repos, jwt)
+	services := service.NewServices(repos, jwt, mailer, paymentProvider, paymentWebhookSecret, cfg.Auth.VerificationCodeLength, cfg.Events.Moderation)
 	services.EventUpdater.Start(context.Background())
+	services.EventPublisher.Start(context.Background())
+	services.ReservationExpirer.Start(context.Background())
+	services.SeriesMaterializer.Start(context.Background())
+	services.RefundRetrier.Start(context.Background())
 
 	adminEmail, err := helpers.GetEnv("ADMIN_EMAIL")
 	if err != nil {
@@ -78,7 +112,7 @@ func Run(configPath string) {
 	}
 
 	// Initializing middleware
-	authMiddleware := middleware.NewAuthMiddleware(jwt)
+	authMiddleware := middleware.NewAuthMiddleware(jwt, services.Policy, services.Sessions)
 
 	// Initializing router and handlers
 	router := infrastructure.NewRouter(services, authMiddleware)
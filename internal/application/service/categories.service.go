@@ -0,0 +1,104 @@
+// internal/application/service/categories.service.go
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type Categories interface {
+	GetCategories(ctx context.Context) ([]*entities.Category, error)
+	CreateCategory(ctx context.Context, input *requests.CreateCategoryRequest) (*entities.Category, error)
+	UpdateCategory(ctx context.Context, input *requests.UpdateCategoryRequest) (*entities.Category, error)
+	DeleteCategory(ctx context.Context, input *requests.DeleteCategoryRequest) error
+}
+
+type categoriesService struct {
+	repo   repository.CategoriesRepository
+	policy Policy
+}
+
+func NewCategoriesService(repo repository.CategoriesRepository, policy Policy) *categoriesService {
+	return &categoriesService{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+func (s *categoriesService) GetCategories(ctx context.Context) ([]*entities.Category, error) {
+	return s.repo.GetCategories(ctx)
+}
+
+func (s *categoriesService) CreateCategory(ctx context.Context, input *requests.CreateCategoryRequest) (*entities.Category, error) {
+	// Categories are shared by every event, they're managed platform-wide
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceCategories, values.ActionCreate, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	category, err := toCategory(&input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *categoriesService) UpdateCategory(ctx context.Context, input *requests.UpdateCategoryRequest) (*entities.Category, error) {
+	// Categories are shared by every event, they're managed platform-wide
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceCategories, values.ActionUpdate, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	category, err := toCategory(&input.Body)
+	if err != nil {
+		return nil, err
+	}
+	category.ID = input.ID
+
+	if err := s.repo.UpdateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *categoriesService) DeleteCategory(ctx context.Context, input *requests.DeleteCategoryRequest) error {
+	// Categories are shared by every event, they're managed platform-wide
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceCategories, values.ActionDelete, values.ScopeAny); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteCategory(ctx, input.ID)
+}
+
+func toCategory(body *requests.CategoryRequestBody) (*entities.Category, error) {
+	name := strings.TrimSpace(body.Name)
+	slug := categorySlug(name)
+	if slug == "" {
+		return nil, domainErrors.ErrInvalidCategoryName
+	}
+
+	return &entities.Category{
+		Name: name,
+		Slug: slug,
+	}, nil
+}
+
+// categorySlug lowercases the name and joins its words with dashes, e.g. "Stand-up & Comedy" gives "stand-up-comedy"
+func categorySlug(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+	return strings.Join(words, "-")
+}
@@ -0,0 +1,122 @@
+// internal/application/service/event_reviews.service.go
+package service
+
+import (
+	"context"
+	"time"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type EventReviews interface {
+	ApproveEvent(ctx context.Context, input *requests.ApproveEventRequest) (*entities.Event, error)
+	RejectEvent(ctx context.Context, input *requests.RejectEventRequest) (*entities.Event, error)
+	GetEventReviews(ctx context.Context, input *requests.GetEventReviewsRequest) ([]*entities.EventReview, error)
+}
+
+type eventReviewsService struct {
+	repo       repository.EventReviewsRepository
+	eventsRepo repository.EventsRepository
+	commonRepo repository.CommonRepository
+	policy     Policy
+}
+
+func NewEventReviewsService(repo repository.EventReviewsRepository, eventsRepo repository.EventsRepository, commonRepo repository.CommonRepository, policy Policy) *eventReviewsService {
+	return &eventReviewsService{
+		repo:       repo,
+		eventsRepo: eventsRepo,
+		commonRepo: commonRepo,
+		policy:     policy,
+	}
+}
+
+func (s *eventReviewsService) ApproveEvent(ctx context.Context, input *requests.ApproveEventRequest) (*entities.Event, error) {
+	event, err := s.pendingEvent(ctx, input.ID, input.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// The event goes live at the time its organizer asked for
+	status := values.EventStatusPublished
+	publishAt := event.PublishAt
+	if publishAt != nil && publishAt.After(time.Now()) {
+		status = values.EventStatusScheduled
+	} else {
+		publishAt = nil
+	}
+
+	review := &entities.EventReview{
+		EventID:    input.ID,
+		ReviewerID: input.AdminID,
+		Action:     values.ReviewActionApproved,
+	}
+	if err := s.repo.SaveReview(ctx, review, values.EventStatusPendingReview, status, publishAt); err != nil {
+		return nil, err
+	}
+
+	event.Status = status
+	event.PublishAt = publishAt
+	return event, nil
+}
+
+func (s *eventReviewsService) RejectEvent(ctx context.Context, input *requests.RejectEventRequest) (*entities.Event, error) {
+	event, err := s.pendingEvent(ctx, input.ID, input.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejected events go back to their organizer as drafts
+	review := &entities.EventReview{
+		EventID:    input.ID,
+		ReviewerID: input.AdminID,
+		Action:     values.ReviewActionRejected,
+		Reason:     input.Body.Reason,
+	}
+	if err := s.repo.SaveReview(ctx, review, values.EventStatusPendingReview, values.EventStatusDraft, nil); err != nil {
+		return nil, err
+	}
+
+	event.Status = values.EventStatusDraft
+	event.PublishAt = nil
+	return event, nil
+}
+
+func (s *eventReviewsService) GetEventReviews(ctx context.Context, input *requests.GetEventReviewsRequest) ([]*entities.EventReview, error) {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceEvents, values.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	// For organizer, verify they may see the event
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckEventPermission(ctx, input.ID, input.OrganizerID, values.OrganizationPermissionViewEvents); err != nil {
+			return nil, types.ErrNotAuthorized
+		}
+	}
+
+	return s.repo.GetEventReviews(ctx, input.ID)
+}
+
+// pendingEvent loads an event a reviewer is about to decide on
+func (s *eventReviewsService) pendingEvent(ctx context.Context, eventID, role string) (*entities.Event, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, role, values.ResourceEvents, values.ActionReview, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventsRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Status != values.EventStatusPendingReview {
+		return nil, domainErrors.ErrEventNotPendingReview
+	}
+	return event, nil
+}
@@ -0,0 +1,268 @@
+// internal/application/service/event_series.service.go
+package service
+
+import (
+	"context"
+	"time"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/recurrence"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type EventSeries interface {
+	CreateSeries(ctx context.Context, input *requests.CreateEventSeriesRequest) (*entities.EventSeries, error)
+	GetSeriesByOrganizer(ctx context.Context, input *requests.GetEventSeriesByOrganizerRequest) ([]*entities.EventSeries, error)
+	GetSeriesByID(ctx context.Context, input *requests.GetEventSeriesRequest) (*entities.EventSeries, error)
+	UpdateSeries(ctx context.Context, input *requests.UpdateEventSeriesRequest) (*entities.EventSeries, error)
+	PublishSeries(ctx context.Context, input *requests.PublishEventSeriesRequest) (*entities.EventSeries, error)
+}
+
+type eventSeriesService struct {
+	repo              repository.EventSeriesRepository
+	commonRepo        repository.CommonRepository
+	venuesRepo        repository.VenuesRepository
+	organizationsRepo repository.OrganizationsRepository
+	policy            Policy
+	moderation        bool // Organizers' events need an admin's approval to go live
+}
+
+func NewEventSeriesService(repo repository.EventSeriesRepository, commonRepo repository.CommonRepository, venuesRepo repository.VenuesRepository, organizationsRepo repository.OrganizationsRepository, policy Policy, moderation bool) *eventSeriesService {
+	return &eventSeriesService{
+		repo:              repo,
+		commonRepo:        commonRepo,
+		venuesRepo:        venuesRepo,
+		organizationsRepo: organizationsRepo,
+		policy:            policy,
+		moderation:        moderation,
+	}
+}
+
+func (s *eventSeriesService) CreateSeries(ctx context.Context, input *requests.CreateEventSeriesRequest) (*entities.EventSeries, error) {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceEventSeries, values.ActionCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify organizer was approved
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckIfOrganizerIsApproved(ctx, input.OrganizerID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Body.StartsAt.Before(time.Now()) {
+		return nil, domainErrors.ErrEventDateInvalid
+	}
+
+	series, err := s.toSeries(ctx, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	series.OrganizerID = input.OrganizerID
+
+	series.OrganizationID, err = eventOrganization(ctx, s.organizationsRepo, s.commonRepo, input.Body.OrganizationID, input.OrganizerID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateSeries(ctx, series); err != nil {
+		return nil, err
+	}
+
+	startTimes, err := upcomingOccurrences(series)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.MaterializeOccurrences(ctx, series.ID, startTimes); err != nil {
+		return nil, err
+	}
+
+	return s.withOccurrences(ctx, series)
+}
+
+func (s *eventSeriesService) GetSeriesByOrganizer(ctx context.Context, input *requests.GetEventSeriesByOrganizerRequest) ([]*entities.EventSeries, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceEventSeries, values.ActionRead, values.ScopeOwn); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetSeriesByOrganizer(ctx, input.OrganizerID)
+}
+
+func (s *eventSeriesService) GetSeriesByID(ctx context.Context, input *requests.GetEventSeriesRequest) (*entities.EventSeries, error) {
+	if _, err := s.checkSeriesAccess(ctx, input.ID, input.OrganizerID, input.Role, values.ActionRead); err != nil {
+		return nil, err
+	}
+
+	series, err := s.repo.GetSeriesByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withOccurrences(ctx, series)
+}
+
+func (s *eventSeriesService) UpdateSeries(ctx context.Context, input *requests.UpdateEventSeriesRequest) (*entities.EventSeries, error) {
+	if _, err := s.checkSeriesAccess(ctx, input.ID, input.OrganizerID, input.Role, values.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetSeriesByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A running series keeps its first occurrence, a new one can't be in the past
+	if !input.Body.StartsAt.Equal(existing.StartsAt) && input.Body.StartsAt.Before(time.Now()) {
+		return nil, domainErrors.ErrEventDateInvalid
+	}
+
+	series, err := s.toSeries(ctx, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	series.ID = existing.ID
+	series.OrganizerID = existing.OrganizerID
+	series.OrganizationID = existing.OrganizationID
+
+	startTimes, err := upcomingOccurrences(series)
+	if err != nil {
+		return nil, err
+	}
+
+	// Under moderation significant changes take the live series and occurrences back to review
+	var review *entities.EventReview
+	if s.moderation && s.policy.Authorize(ctx, input.Role, values.ResourceEvents, values.ActionReview, values.ScopeAny) != nil {
+		review = &entities.EventReview{
+			ReviewerID: input.OrganizerID,
+			Action:     values.ReviewActionSubmitted,
+		}
+	}
+
+	if err := s.repo.UpdateSeries(ctx, series, startTimes, review); err != nil {
+		return nil, err
+	}
+
+	return s.withOccurrences(ctx, series)
+}
+
+func (s *eventSeriesService) PublishSeries(ctx context.Context, input *requests.PublishEventSeriesRequest) (*entities.EventSeries, error) {
+	scope, err := s.checkSeriesAccess(ctx, input.ID, input.OrganizerID, input.Role, values.ActionPublish)
+	if err != nil {
+		return nil, err
+	}
+
+	// Published series keep creating live occurrences, under moderation only reviewers publish them
+	if s.moderation {
+		if err := s.policy.Authorize(ctx, input.Role, values.ResourceEvents, values.ActionReview, values.ScopeAny); err != nil {
+			return nil, err
+		}
+	}
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckIfOrganizerIsApproved(ctx, input.OrganizerID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.PublishSeries(ctx, input.ID); err != nil {
+		return nil, err
+	}
+
+	series, err := s.repo.GetSeriesByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withOccurrences(ctx, series)
+}
+
+// checkSeriesAccess allows roles with the action on any series, and the members of the series' organization
+// whose role covers it. It returns the scope the action was allowed in.
+func (s *eventSeriesService) checkSeriesAccess(ctx context.Context, seriesID, organizerID, role, action string) (string, error) {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, role, values.ResourceEventSeries, action)
+	if err != nil {
+		return "", err
+	}
+
+	if scope == values.ScopeOwn {
+		permission := values.OrganizationPermissionManageEvents
+		if action == values.ActionRead {
+			permission = values.OrganizationPermissionViewEvents
+		}
+		if err := s.repo.ValidateSeriesPermission(ctx, seriesID, organizerID, permission); err != nil {
+			return "", types.ErrNotAuthorized
+		}
+	}
+
+	return scope, nil
+}
+
+// toSeries validates the request body and turns it into a series
+func (s *eventSeriesService) toSeries(ctx context.Context, body *requests.EventSeriesRequestBody) (*entities.EventSeries, error) {
+	if _, err := recurrence.Parse(body.RRule); err != nil {
+		return nil, err
+	}
+
+	timezone := body.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, domainErrors.ErrInvalidTimezone
+	}
+
+	location, err := eventLocation(ctx, s.venuesRepo, body.VenueID, body.Location, body.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	if body.CategoryID != "" {
+		if err := s.commonRepo.CheckIfCategoryExists(ctx, body.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entities.EventSeries{
+		Title:           body.Title,
+		Description:     body.Description,
+		Location:        location,
+		VenueID:         body.VenueID,
+		CategoryID:      body.CategoryID,
+		StartsAt:        body.StartsAt.Truncate(time.Second),
+		DurationMinutes: body.DurationMinutes,
+		Timezone:        timezone,
+		RRule:           body.RRule,
+		Exceptions:      body.Exceptions,
+		Capacity:        body.Capacity,
+		Price:           body.Price,
+
+		ReservationTTLMinutes: reservationTTLOrDefault(body.ReservationTTLMinutes),
+		SalesCutoffMinutes:    body.SalesCutoffMinutes,
+		RefundPercent:         body.RefundPercent,
+		RefundDeadlineHours:   body.RefundDeadlineHours,
+	}, nil
+}
+
+// withOccurrences attaches the upcoming occurrences to the series
+func (s *eventSeriesService) withOccurrences(ctx context.Context, series *entities.EventSeries) (*entities.EventSeries, error) {
+	occurrences, err := s.repo.GetUpcomingOccurrences(ctx, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	series.Occurrences = occurrences
+	return series, nil
+}
+
+// upcomingOccurrences lists the start times of the occurrences within the materialization horizon
+func upcomingOccurrences(series *entities.EventSeries) ([]time.Time, error) {
+	now := time.Now()
+	return series.OccurrencesBetween(now, now.AddDate(0, 0, values.SeriesHorizonDays))
+}
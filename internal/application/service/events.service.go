@@ -3,61 +3,225 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	types "ticket-booking-app-backend/internal/application/types/errors"
 	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/application/types/responses"
 	"ticket-booking-app-backend/internal/domain/entities"
 	"ticket-booking-app-backend/internal/domain/repository"
 	domainErrors "ticket-booking-app-backend/internal/domain/types"
 	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/sirupsen/logrus"
 )
 
 type Events interface {
-	GetEvents(ctx context.Context, input *requests.GetEventsRequest) ([]*entities.Event, error)
-	GetEventsByOrganizer(ctx context.Context, input *requests.GetEventsByOrganizerRequest) ([]*entities.Event, error)
+	GetEvents(ctx context.Context, input *requests.GetEventsRequest) (*responses.EventsPage, error)
+	GetEventsByOrganizer(ctx context.Context, input *requests.GetEventsByOrganizerRequest) (*responses.EventsPage, error)
+	GetNearbyEvents(ctx context.Context, input *requests.GetNearbyEventsRequest) (*responses.NearbyEventsPage, error)
 	GetEventByID(ctx context.Context, input *requests.GetEventByIDRequest) (*entities.Event, error)
 	CreateEvent(ctx context.Context, input *requests.CreateEventRequest) error
 	UpdateEvent(ctx context.Context, input *requests.UpdateEventRequest) (*entities.Event, error)
+	PublishEvent(ctx context.Context, input *requests.PublishEventRequest) (*entities.Event, error)
 	CancelEvent(ctx context.Context, input *requests.CancelEventRequest) error
 	DeleteEvent(ctx context.Context, input *requests.DeleteEventRequest) error
 }
 
 type eventsService struct {
-	repo       repository.EventsRepository
-	commonRepo repository.CommonRepository
+	repo              repository.EventsRepository
+	searchRepo        repository.SearchRepository
+	commonRepo        repository.CommonRepository
+	ticketsRepo       repository.TicketsRepository
+	venuesRepo        repository.VenuesRepository
+	reviewsRepo       repository.EventReviewsRepository
+	organizationsRepo repository.OrganizationsRepository
+	payments          Payments
+	policy            Policy
+	moderation        bool // Organizers' events need an admin's approval to go live
 }
 
-func NewEventsService(repo repository.EventsRepository, commonRepo repository.CommonRepository) *eventsService {
+func NewEventsService(repo repository.EventsRepository, searchRepo repository.SearchRepository, commonRepo repository.CommonRepository, ticketsRepo repository.TicketsRepository, venuesRepo repository.VenuesRepository, reviewsRepo repository.EventReviewsRepository, organizationsRepo repository.OrganizationsRepository, payments Payments, policy Policy, moderation bool) *eventsService {
 	return &eventsService{
-		repo:       repo,
-		commonRepo: commonRepo,
+		repo:              repo,
+		searchRepo:        searchRepo,
+		commonRepo:        commonRepo,
+		ticketsRepo:       ticketsRepo,
+		venuesRepo:        venuesRepo,
+		reviewsRepo:       reviewsRepo,
+		organizationsRepo: organizationsRepo,
+		payments:          payments,
+		policy:            policy,
+		moderation:        moderation,
 	}
 }
 
-func (s *eventsService) GetEvents(ctx context.Context, input *requests.GetEventsRequest) ([]*entities.Event, error) {
-	// For regular users, only return active events
-	if input.Role == values.UserRole {
-		input.Status = values.EventStatusActive
+func (s *eventsService) GetEvents(ctx context.Context, input *requests.GetEventsRequest) (*responses.EventsPage, error) {
+	// Roles that can't read any event only get the ones open to the public
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceEvents, values.ActionRead, values.ScopeAny); err != nil {
+		if !errors.Is(err, types.ErrNotAuthorized) {
+			return nil, err
+		}
+		input.Statuses = entities.PublicEventStatuses
+	}
+
+	filter, err := eventFilter(&input.Query)
+	if err != nil {
+		return nil, err
 	}
+	filter.Statuses = input.Statuses
 
-	return s.repo.GetEvents(ctx, input.Status)
+	return s.getEventsPage(ctx, filter)
 }
 
-func (s *eventsService) GetEventsByOrganizer(ctx context.Context, input *requests.GetEventsByOrganizerRequest) ([]*entities.Event, error) {
-	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return nil, types.ErrNotAuthorized
+func (s *eventsService) GetEventsByOrganizer(ctx context.Context, input *requests.GetEventsByOrganizerRequest) (*responses.EventsPage, error) {
+	// Verify permissions, the filter keeps the events the organizer may see
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceEvents, values.ActionRead, values.ScopeOwn); err != nil {
+		return nil, err
 	}
 
-	// For organizer, verify they exist
-	if input.Role == values.OrganizerRole {
-		if err := s.commonRepo.CheckIfUserExistsByIdAndRole(ctx, input.OrganizerID, values.OrganizerRole); err != nil {
-			return nil, err
-		}
+	filter, err := eventFilter(&input.Query)
+	if err != nil {
+		return nil, err
+	}
+	if input.Status != "" {
+		filter.Statuses = []string{input.Status}
+	}
+	filter.OrganizerID = input.OrganizerID
+
+	return s.getEventsPage(ctx, filter)
+}
+
+func (s *eventsService) GetNearbyEvents(ctx context.Context, input *requests.GetNearbyEventsRequest) (*responses.NearbyEventsPage, error) {
+	radius := input.Query.Radius
+	if radius <= 0 {
+		radius = values.DefaultNearbyRadiusKm
+	}
+	if radius > values.MaxNearbyRadiusKm {
+		radius = values.MaxNearbyRadiusKm
+	}
+
+	// Only events people can still go to
+	filter, err := eventFilter(&requests.EventsQuery{
+		Limit:  input.Query.Limit,
+		Offset: input.Query.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	filter.Statuses = entities.PublicEventStatuses
+
+	events, total, err := s.repo.GetNearbyEvents(ctx, *input.Query.Lat, *input.Query.Lng, radius, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.NearbyEventsPage{
+		Events: events,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+func (s *eventsService) getEventsPage(ctx context.Context, filter *entities.EventFilter) (*responses.EventsPage, error) {
+	var page *responses.EventsPage
+	var err error
+	if filter.Search != "" {
+		page, err = s.searchEventsPage(ctx, filter)
+	} else {
+		page, err = s.listEventsPage(ctx, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Counts for the filter sidebar of the same listing
+	page.Facets, err = s.repo.GetEventFacets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (s *eventsService) listEventsPage(ctx context.Context, filter *entities.EventFilter) (*responses.EventsPage, error) {
+	events, total, err := s.repo.GetEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.EventsPage{
+		Events: events,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+func (s *eventsService) searchEventsPage(ctx context.Context, filter *entities.EventFilter) (*responses.EventsPage, error) {
+	// Keep statuses current, like the plain listing does
+	if err := s.repo.UpdateEventStatuses(ctx); err != nil {
+		logrus.Errorf("Failed to update event statuses: %v", err)
+	}
+
+	hits, total, err := s.searchRepo.SearchEvents(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.repo.GetEventsByOrganizer(ctx, input.Status, input.OrganizerID)
+	page := &responses.EventsPage{
+		Events:     make([]*entities.Event, len(hits)),
+		Highlights: make(map[string]entities.EventHighlight, len(hits)),
+		Total:      total,
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+	for i, hit := range hits {
+		page.Events[i] = hit.Event
+		page.Highlights[hit.Event.ID] = hit.Highlight
+	}
+
+	return page, nil
+}
+
+// eventFilter turns the listing query parameters into a repository filter
+func eventFilter(query *requests.EventsQuery) (*entities.EventFilter, error) {
+	if query.DateFrom != nil && query.DateTo != nil && query.DateFrom.After(*query.DateTo) {
+		return nil, domainErrors.ErrInvalidEventFilter
+	}
+	if query.MinPrice != nil && query.MaxPrice != nil && *query.MinPrice > *query.MaxPrice {
+		return nil, domainErrors.ErrInvalidEventFilter
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = values.DefaultEventsPageSize
+	}
+	if limit > values.MaxEventsPageSize {
+		limit = values.MaxEventsPageSize
+	}
+
+	// A leading "-" sorts descending, e.g. "-price"
+	sortBy := strings.TrimPrefix(query.Sort, "-")
+
+	return &entities.EventFilter{
+		Search:     strings.TrimSpace(query.Search),
+		Location:   strings.TrimSpace(query.Location),
+		VenueID:    query.VenueID,
+		CategoryID: query.Category,
+		Tags:       normalizeTags(query.Tags),
+		DateFrom:   query.DateFrom,
+		DateTo:     query.DateTo,
+		PriceMin:   query.MinPrice,
+		PriceMax:   query.MaxPrice,
+		SortBy:     sortBy,
+		SortDesc:   sortBy != query.Sort,
+		Limit:      limit,
+		Offset:     query.Offset,
+	}, nil
 }
 
 func (s *eventsService) GetEventByID(ctx context.Context, input *requests.GetEventByIDRequest) (*entities.Event, error) {
@@ -66,54 +230,99 @@ func (s *eventsService) GetEventByID(ctx context.Context, input *requests.GetEve
 		return nil, err
 	}
 
-	// Regular users can only view active events
-	if input.Role == values.UserRole && event.Status != values.EventStatusActive {
-		return nil, types.ErrNotAuthorized
+	if err := checkEventVisible(ctx, s.policy, s.commonRepo, event, input.OrganizerID, input.Role); err != nil {
+		return nil, err
 	}
 
-	// Organizers can only view their own events
-	if input.Role == values.OrganizerRole {
-		return nil, types.ErrNotAuthorized
+	return event, nil
+}
+
+// checkEventVisible fails with types.ErrNotAuthorized unless the user may see the event
+func checkEventVisible(ctx context.Context, policy Policy, commonRepo repository.CommonRepository, event *entities.Event, userID, role string) error {
+	// Events open to the public can be read by everyone
+	if entities.IsPublicEventStatus(event.Status) {
+		return nil
 	}
 
-	return event, nil
+	scope, err := policy.Scope(ctx, role, values.ResourceEvents, values.ActionRead)
+	if err != nil {
+		return err
+	}
+
+	// Organizers can only view the events their organizations let them see
+	if scope == values.ScopeOwn {
+		if err := commonRepo.CheckEventPermission(ctx, event.ID, userID, values.OrganizationPermissionViewEvents); err != nil {
+			return types.ErrNotAuthorized
+		}
+	}
+
+	return nil
 }
 
 func (s *eventsService) CreateEvent(ctx context.Context, input *requests.CreateEventRequest) error {
 	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return types.ErrNotAuthorized
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceEvents, values.ActionCreate)
+	if err != nil {
+		return err
 	}
 
-	// Verify organizer exists
-	if input.Role == values.OrganizerRole {
-		if err := s.commonRepo.CheckIfUserExistsByIdAndRole(ctx, input.OrganizerID, values.OrganizerRole); err != nil {
+	// Verify organizer was approved
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckIfOrganizerIsApproved(ctx, input.OrganizerID); err != nil {
 			return err
 		}
 	}
 
 	// Validate event date
-	if input.Body.Date.Before(time.Now()) {
+	if input.Body.StartsAt.Before(time.Now()) {
 		return domainErrors.ErrEventDateInvalid
 	}
 
+	location, err := eventLocation(ctx, s.venuesRepo, input.Body.VenueID, input.Body.Location, input.Body.Capacity)
+	if err != nil {
+		return err
+	}
+
+	if input.Body.CategoryID != "" {
+		if err := s.commonRepo.CheckIfCategoryExists(ctx, input.Body.CategoryID); err != nil {
+			return err
+		}
+	}
+
+	organizationID, err := eventOrganization(ctx, s.organizationsRepo, s.commonRepo, input.Body.OrganizationID, input.OrganizerID, scope)
+	if err != nil {
+		return err
+	}
+
 	event := &entities.Event{
 		Title:       input.Body.Title,
 		Description: input.Body.Description,
-		Location:    input.Body.Location,
-		Date:        input.Body.Date,
+		Location:    location,
+		VenueID:     input.Body.VenueID,
+		CategoryID:  input.Body.CategoryID,
+		Tags:        normalizeTags(input.Body.Tags),
+		StartsAt:    input.Body.StartsAt,
+		EndsAt:      input.Body.EndsAt,
 		Capacity:    input.Body.Capacity,
 		Price:       input.Body.Price,
-		Status:      values.EventStatusActive,
+		Status:      values.EventStatusDraft,
+
+		OrganizationID: organizationID,
+
+		ReservationTTLMinutes: reservationTTLOrDefault(input.Body.ReservationTTLMinutes),
+		SalesCutoffMinutes:    input.Body.SalesCutoffMinutes,
+		RefundPercent:         input.Body.RefundPercent,
+		RefundDeadlineHours:   input.Body.RefundDeadlineHours,
 	}
 
 	return s.repo.CreateEvent(ctx, event, input.OrganizerID)
 }
 
 func (s *eventsService) UpdateEvent(ctx context.Context, input *requests.UpdateEventRequest) (*entities.Event, error) {
-	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return nil, types.ErrNotAuthorized
+	// Verify permissions, organizers are limited to the events they manage
+	organizerID, err := s.managingOrganizer(ctx, input.OrganizerID, input.Role, values.ActionUpdate)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get existing event
@@ -123,38 +332,95 @@ func (s *eventsService) UpdateEvent(ctx context.Context, input *requests.UpdateE
 	}
 
 	// Can't update finished or cancelled events
-	if existingEvent.Status == values.EventStatusFinished || existingEvent.Status == values.EventStatusCancelled {
+	if entities.IsFinalEventStatus(existingEvent.Status) {
 		return nil, domainErrors.ErrEventAlreadyFinished
 	}
 
-	// Validate event date
-	if input.Body.Date.Before(time.Now()) {
+	// Validate event date, an ongoing event keeps its start and can only move its end
+	startsAt := input.Body.StartsAt
+	if existingEvent.Status == values.EventStatusOngoing {
+		startsAt = existingEvent.StartsAt
+		if !input.Body.EndsAt.After(time.Now()) {
+			return nil, domainErrors.ErrEventDateInvalid
+		}
+	} else if startsAt.Before(time.Now()) {
+		return nil, domainErrors.ErrEventDateInvalid
+	}
+	if !input.Body.EndsAt.After(startsAt) {
 		return nil, domainErrors.ErrEventDateInvalid
 	}
 
+	// Seated events sell exactly the seats of their map and stay at its venue
+	capacity := input.Body.Capacity
+	venueID := input.Body.VenueID
+	if existingEvent.SeatMapID != "" {
+		capacity = existingEvent.Capacity
+		venueID = existingEvent.VenueID
+	}
+
+	location, err := eventLocation(ctx, s.venuesRepo, venueID, input.Body.Location, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Body.CategoryID != "" {
+		if err := s.commonRepo.CheckIfCategoryExists(ctx, input.Body.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
 	event := &entities.Event{
 		ID:          input.ID,
 		Title:       input.Body.Title,
 		Description: input.Body.Description,
-		Location:    input.Body.Location,
-		Date:        input.Body.Date,
-		Capacity:    input.Body.Capacity,
+		Location:    location,
+		VenueID:     venueID,
+		CategoryID:  input.Body.CategoryID,
+		Tags:        normalizeTags(input.Body.Tags),
+		StartsAt:    startsAt,
+		EndsAt:      input.Body.EndsAt,
+		Capacity:    capacity,
 		Price:       input.Body.Price,
 		Status:      existingEvent.Status,
+		SeatMapID:   existingEvent.SeatMapID,
+
+		ReservationTTLMinutes: reservationTTLOrDefault(input.Body.ReservationTTLMinutes),
+		SalesCutoffMinutes:    input.Body.SalesCutoffMinutes,
+		RefundPercent:         input.Body.RefundPercent,
+		RefundDeadlineHours:   input.Body.RefundDeadlineHours,
 	}
 
-	err = s.repo.UpdateEvent(ctx, input.OrganizerID, event)
+	err = s.repo.UpdateEvent(ctx, organizerID, event)
 	if err != nil {
 		return nil, err
 	}
 
+	// Under moderation significant changes take a live event back to review
+	if s.moderation && !s.canReview(ctx, input.Role) &&
+		(existingEvent.Status == values.EventStatusPublished || existingEvent.Status == values.EventStatusScheduled) {
+		if changes := significantEventChanges(existingEvent, event); len(changes) > 0 {
+			review := &entities.EventReview{
+				EventID:    input.ID,
+				ReviewerID: input.OrganizerID,
+				Action:     values.ReviewActionSubmitted,
+				Reason:     "changed " + strings.Join(changes, ", "),
+			}
+			if err := s.reviewsRepo.SaveReview(ctx, review, existingEvent.Status, values.EventStatusPendingReview, existingEvent.PublishAt); err != nil {
+				return nil, err
+			}
+			event.Status = values.EventStatusPendingReview
+			event.PublishAt = existingEvent.PublishAt
+		}
+	}
+
 	return event, nil
 }
 
 func (s *eventsService) DeleteEvent(ctx context.Context, input *requests.DeleteEventRequest) error {
-	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return types.ErrNotAuthorized
+	// Verify permissions, organizers are limited to the events they manage
+	organizerID, err := s.managingOrganizer(ctx, input.OrganizerID, input.Role, values.ActionDelete)
+	if err != nil {
+		return err
 	}
 
 	// Get existing event
@@ -168,13 +434,77 @@ func (s *eventsService) DeleteEvent(ctx context.Context, input *requests.DeleteE
 		return domainErrors.ErrEventAlreadyFinished
 	}
 
-	return s.repo.DeleteEvent(ctx, input.ID, input.OrganizerID)
+	return s.repo.DeleteEvent(ctx, input.ID, organizerID)
 }
 
-func (s *eventsService) CancelEvent(ctx context.Context, input *requests.CancelEventRequest) error {
+func (s *eventsService) PublishEvent(ctx context.Context, input *requests.PublishEventRequest) (*entities.Event, error) {
 	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return types.ErrNotAuthorized
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceEvents, values.ActionPublish)
+	if err != nil {
+		return nil, err
+	}
+
+	// For organizer, verify they may manage the event and sell tickets
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckEventPermission(ctx, input.ID, input.OrganizerID, values.OrganizationPermissionManageEvents); err != nil {
+			return nil, types.ErrNotAuthorized
+		}
+		if err := s.commonRepo.CheckIfOrganizerIsApproved(ctx, input.OrganizerID); err != nil {
+			return nil, err
+		}
+	}
+
+	event, err := s.repo.GetEventByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A publish time in the future schedules the event instead
+	status := values.EventStatusPublished
+	publishAt := input.Body.PublishAt
+	if publishAt != nil && publishAt.After(time.Now()) {
+		status = values.EventStatusScheduled
+	} else {
+		publishAt = nil
+	}
+
+	// Under moderation an organizer's event waits for a reviewer, who publishes it at the asked time
+	if s.moderation && !s.canReview(ctx, input.Role) {
+		status = values.EventStatusPendingReview
+	}
+
+	if err := checkEventTransition(event.Status, status); err != nil {
+		return nil, err
+	}
+
+	// Events that already started can't go on sale
+	if event.StartsAt.Before(time.Now()) || (publishAt != nil && publishAt.After(event.StartsAt)) {
+		return nil, domainErrors.ErrEventDateInvalid
+	}
+
+	if status == values.EventStatusPendingReview {
+		review := &entities.EventReview{
+			EventID:    input.ID,
+			ReviewerID: input.OrganizerID,
+			Action:     values.ReviewActionSubmitted,
+		}
+		if err := s.reviewsRepo.SaveReview(ctx, review, event.Status, status, publishAt); err != nil {
+			return nil, err
+		}
+	} else if err := s.repo.UpdateEventPublishing(ctx, input.ID, event.Status, status, publishAt); err != nil {
+		return nil, err
+	}
+
+	event.Status = status
+	event.PublishAt = publishAt
+	return event, nil
+}
+
+func (s *eventsService) CancelEvent(ctx context.Context, input *requests.CancelEventRequest) error {
+	// Verify permissions, organizers are limited to the events they manage
+	organizerID, err := s.managingOrganizer(ctx, input.OrganizerID, input.Role, values.ActionCancel)
+	if err != nil {
+		return err
 	}
 
 	// Get existing event
@@ -183,10 +513,118 @@ func (s *eventsService) CancelEvent(ctx context.Context, input *requests.CancelE
 		return err
 	}
 
-	// Can't cancel finished events
-	if existingEvent.Status == values.EventStatusFinished {
+	if err := checkEventTransition(existingEvent.Status, values.EventStatusCancelled); err != nil {
+		return err
+	}
+
+	// Reservations are released and paid tickets marked for a refund with the status change
+	if err := s.repo.CancelEvent(ctx, input.ID, organizerID, existingEvent.Status); err != nil {
+		return err
+	}
+
+	// The event is cancelled either way, refunds that don't go through now are retried by the refund job
+	refunds, err := s.payments.RefundEventTickets(ctx, input.ID)
+	if err != nil {
+		logrus.Errorf("Error refunding tickets of cancelled event %s: %s", input.ID, err)
+		return nil
+	}
+	logrus.Infof("Event %s cancelled, %d ticket(s) refunded", input.ID, len(refunds))
+
+	return nil
+}
+
+// managingOrganizer checks the role may take the action on events and returns the organizer
+// the repository should limit it to, none when the role may take it on any event
+func (s *eventsService) managingOrganizer(ctx context.Context, organizerID, role, action string) (string, error) {
+	scope, err := s.policy.Scope(ctx, role, values.ResourceEvents, action)
+	if err != nil {
+		return "", err
+	}
+	if scope == values.ScopeAny {
+		return "", nil
+	}
+	return organizerID, nil
+}
+
+// canReview tells whether the role decides on events waiting for review, its own changes skip moderation
+func (s *eventsService) canReview(ctx context.Context, role string) bool {
+	return s.policy.Authorize(ctx, role, values.ResourceEvents, values.ActionReview, values.ScopeAny) == nil
+}
+
+// checkEventTransition validates a status change against the event lifecycle
+func checkEventTransition(from, to string) error {
+	if entities.CanTransitionEvent(from, to) {
+		return nil
+	}
+
+	switch from {
+	case values.EventStatusFinished:
 		return domainErrors.ErrEventAlreadyFinished
+	case values.EventStatusCancelled:
+		return domainErrors.ErrEventAlreadyCancelled
+	}
+	return domainErrors.ErrInvalidEventTransition
+}
+
+// significantEventChanges lists what changed about an event that an admin should look at again
+func significantEventChanges(before, after *entities.Event) []string {
+	var changes []string
+	if before.Title != after.Title {
+		changes = append(changes, "title")
+	}
+	if before.Description != after.Description {
+		changes = append(changes, "description")
+	}
+	if before.Location != after.Location || before.VenueID != after.VenueID {
+		changes = append(changes, "location")
+	}
+	if !before.StartsAt.Equal(after.StartsAt) || !before.EndsAt.Equal(after.EndsAt) {
+		changes = append(changes, "dates")
+	}
+	return changes
+}
+
+// normalizeTags lowercases and trims tags and drops empty and repeated ones
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// eventLocation checks that the event fits into its venue and describes where it
+// takes place. Events without a venue keep their free-form location.
+func eventLocation(ctx context.Context, venuesRepo repository.VenuesRepository, venueID, location string, capacity int) (string, error) {
+	if venueID == "" {
+		return location, nil
+	}
+
+	venue, err := venuesRepo.GetVenueByID(ctx, venueID)
+	if err != nil {
+		return "", err
 	}
 
-	return s.repo.UpdateEventStatus(ctx, input.ID, input.OrganizerID, values.EventStatusCancelled)
+	if capacity > venue.DefaultCapacity {
+		return "", domainErrors.ErrVenueCapacityExceeded
+	}
+
+	if location == "" {
+		location = venue.Name + ", " + venue.Address + ", " + venue.City
+	}
+	return location, nil
+}
+
+// reservationTTLOrDefault falls back to the global reservation window when an event doesn't set its own.
+func reservationTTLOrDefault(minutes int) int {
+	if minutes <= 0 {
+		return values.TicketReservationMinutes
+	}
+	return minutes
 }
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	"ticket-booking-app-backend/internal/infrastructure/repositories/memory"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// searchEventsRepository provides what a search needs besides the search index, the
+// listing methods aren't implemented so a search that falls back to them panics
+type searchEventsRepository struct {
+	repository.EventsRepository
+}
+
+func (r *searchEventsRepository) UpdateEventStatuses(ctx context.Context) error {
+	return nil
+}
+
+func (r *searchEventsRepository) GetEventFacets(ctx context.Context, filter *entities.EventFilter) (*entities.EventFacets, error) {
+	return &entities.EventFacets{}, nil
+}
+
+func newSearchTestService(events ...*entities.Event) *eventsService {
+	return NewEventsService(&searchEventsRepository{}, memory.NewSearchRepository(events...),
+		nil, nil, nil, nil, nil, nil, NewPolicyService(nil), false)
+}
+
+func TestGetEventsSearch(t *testing.T) {
+	startsAt := time.Date(2030, time.June, 1, 19, 0, 0, 0, time.UTC)
+	jazzNight := &entities.Event{ID: "1", Title: "Jazz Night", Description: "Quartet by the river",
+		Price: 30, Status: values.EventStatusPublished, StartsAt: startsAt}
+	summerEvening := &entities.Event{ID: "2", Title: "Summer Evening", Description: "Live jazz until midnight",
+		Price: 20, Status: values.EventStatusPublished, StartsAt: startsAt}
+	jazzDraft := &entities.Event{ID: "3", Title: "Jazz Brunch", Description: "Not announced yet",
+		Price: 10, Status: values.EventStatusDraft, StartsAt: startsAt}
+	rockFestival := &entities.Event{ID: "4", Title: "Rock Festival", Description: "Three stages",
+		Price: 50, Status: values.EventStatusPublished, StartsAt: startsAt}
+
+	tests := []struct {
+		name      string
+		role      string
+		query     requests.EventsQuery
+		wantIDs   []string
+		wantTotal int64
+	}{
+		{
+			name:      "title matches rank above description matches",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazz"},
+			wantIDs:   []string{"1", "2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "typos still match",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazzz"},
+			wantIDs:   []string{"1", "2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "roles that can read any event find drafts",
+			role:      values.AdminRole,
+			query:     requests.EventsQuery{Search: "jazz"},
+			wantIDs:   []string{"1", "3", "2"},
+			wantTotal: 3,
+		},
+		{
+			name:      "every term must match",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazz festival"},
+			wantTotal: 0,
+		},
+		{
+			name:      "sorted by price",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazz", Sort: "price"},
+			wantIDs:   []string{"2", "1"},
+			wantTotal: 2,
+		},
+		{
+			name:      "pages keep the total",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazz", Limit: 1, Offset: 1},
+			wantIDs:   []string{"2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "other filters apply to the matches",
+			role:      values.UserRole,
+			query:     requests.EventsQuery{Search: "jazz", MaxPrice: new(float64)},
+			wantTotal: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSearchTestService(jazzNight, summerEvening, jazzDraft, rockFestival)
+
+			page, err := s.GetEvents(context.Background(), &requests.GetEventsRequest{Role: tt.role, Query: tt.query})
+			if err != nil {
+				t.Fatalf("GetEvents: %s", err)
+			}
+
+			if page.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", page.Total, tt.wantTotal)
+			}
+			var ids []string
+			for _, event := range page.Events {
+				ids = append(ids, event.ID)
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("events = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("events = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestGetEventsSearchHighlights(t *testing.T) {
+	event := &entities.Event{ID: "1", Title: "Jazz Night", Description: "Live jazz, all night",
+		Status: values.EventStatusPublished}
+	s := newSearchTestService(event)
+
+	page, err := s.GetEvents(context.Background(), &requests.GetEventsRequest{
+		Role:  values.UserRole,
+		Query: requests.EventsQuery{Search: "  jazz  "},
+	})
+	if err != nil {
+		t.Fatalf("GetEvents: %s", err)
+	}
+
+	highlight, ok := page.Highlights[event.ID]
+	if !ok {
+		t.Fatalf("no highlight for event %s in %v", event.ID, page.Highlights)
+	}
+	if want := "<mark>Jazz</mark> Night"; highlight.Title != want {
+		t.Errorf("title = %q, want %q", highlight.Title, want)
+	}
+	if want := "Live <mark>jazz</mark>, all night"; highlight.Snippet != want {
+		t.Errorf("snippet = %q, want %q", highlight.Snippet, want)
+	}
+	if page.Limit != values.DefaultEventsPageSize {
+		t.Errorf("limit = %d, want the default %d", page.Limit, values.DefaultEventsPageSize)
+	}
+}
+
+func TestGetEventsSearchHighlightsEscapeHTML(t *testing.T) {
+	event := &entities.Event{ID: "1", Title: `<script>alert("jazz")</script> Jazz & Blues`,
+		Description: `<img src=x onerror='jazz()'>`, Status: values.EventStatusPublished}
+	s := newSearchTestService(event)
+
+	page, err := s.GetEvents(context.Background(), &requests.GetEventsRequest{
+		Role:  values.UserRole,
+		Query: requests.EventsQuery{Search: "jazz"},
+	})
+	if err != nil {
+		t.Fatalf("GetEvents: %s", err)
+	}
+
+	highlight := page.Highlights[event.ID]
+	if want := "&lt;script&gt;alert(&#34;<mark>jazz</mark>&#34;)&lt;/script&gt; <mark>Jazz</mark> &amp; Blues"; highlight.Title != want {
+		t.Errorf("title = %q, want %q", highlight.Title, want)
+	}
+	if want := "&lt;img src=x onerror=&#39;<mark>jazz</mark>()&#39;&gt;"; highlight.Snippet != want {
+		t.Errorf("snippet = %q, want %q", highlight.Snippet, want)
+	}
+}
@@ -0,0 +1,269 @@
+// internal/application/service/organizations.service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/internal/infrastructure/mail"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Organizations interface {
+	CreateOrganization(ctx context.Context, input *requests.CreateOrganizationRequest) (*entities.Organization, error)
+	GetOrganizations(ctx context.Context, input *requests.GetOrganizationsRequest) ([]*entities.Organization, error)
+	GetOrganizationByID(ctx context.Context, input *requests.GetOrganizationRequest) (*entities.Organization, error)
+	InviteMember(ctx context.Context, input *requests.InviteMemberRequest) (*entities.OrganizationInvitation, error)
+	GetInvitations(ctx context.Context, input *requests.GetInvitationsRequest) ([]*entities.OrganizationInvitation, error)
+	AcceptInvitation(ctx context.Context, input *requests.AcceptInvitationRequest) (*entities.Organization, error)
+	UpdateMemberRole(ctx context.Context, input *requests.UpdateMemberRoleRequest) (*entities.OrganizationMember, error)
+	RemoveMember(ctx context.Context, input *requests.RemoveMemberRequest) error
+}
+
+type organizationsService struct {
+	repo       repository.OrganizationsRepository
+	usersRepo  repository.UsersRepository
+	commonRepo repository.CommonRepository
+	mailer     mail.Mailer
+	policy     Policy
+}
+
+func NewOrganizationsService(repo repository.OrganizationsRepository, usersRepo repository.UsersRepository, commonRepo repository.CommonRepository, mailer mail.Mailer, policy Policy) *organizationsService {
+	return &organizationsService{
+		repo:       repo,
+		usersRepo:  usersRepo,
+		commonRepo: commonRepo,
+		mailer:     mailer,
+		policy:     policy,
+	}
+}
+
+func (s *organizationsService) CreateOrganization(ctx context.Context, input *requests.CreateOrganizationRequest) (*entities.Organization, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceOrganizations, values.ActionCreate, values.ScopeOwn); err != nil {
+		return nil, err
+	}
+
+	organization := &entities.Organization{
+		Name:    strings.TrimSpace(input.Body.Name),
+		OwnerID: input.UserID,
+	}
+	if err := s.repo.CreateOrganization(ctx, organization); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetOrganizationByID(ctx, organization.ID)
+}
+
+func (s *organizationsService) GetOrganizations(ctx context.Context, input *requests.GetOrganizationsRequest) ([]*entities.Organization, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceOrganizations, values.ActionRead, values.ScopeOwn); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetOrganizationsByUser(ctx, input.UserID)
+}
+
+func (s *organizationsService) GetOrganizationByID(ctx context.Context, input *requests.GetOrganizationRequest) (*entities.Organization, error) {
+	// Every member can see the organization and its members
+	if err := s.checkOrganizationAccess(ctx, input.ID, input.UserID, input.Role, values.ActionRead, values.OrganizationPermissionViewEvents); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetOrganizationByID(ctx, input.ID)
+}
+
+// InviteMember creates an invitation to join the organization, its token is handed out once
+// to be passed on to the invited email
+func (s *organizationsService) InviteMember(ctx context.Context, input *requests.InviteMemberRequest) (*entities.OrganizationInvitation, error) {
+	if err := s.checkOrganizationAccess(ctx, input.ID, input.UserID, input.Role, values.ActionUpdate, values.OrganizationPermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	organization, err := s.repo.GetOrganizationByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Organizations have a single owner, invitations are for the other roles
+	if !entities.IsOrganizationRole(input.Body.Role) || input.Body.Role == values.OrganizationRoleOwner {
+		return nil, domainErrors.ErrInvalidOrganizationRole
+	}
+
+	email := strings.ToLower(strings.TrimSpace(input.Body.Email))
+	if user, err := s.usersRepo.GetByEmail(ctx, email); err == nil {
+		if _, err := s.repo.GetMember(ctx, input.ID, user.ID); err == nil {
+			return nil, domainErrors.ErrOrganizationMemberExists
+		}
+	}
+
+	token, err := helpers.NewToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &entities.OrganizationInvitation{
+		OrganizationID: input.ID,
+		Email:          email,
+		Role:           input.Body.Role,
+		InvitedBy:      input.UserID,
+		Token:          token,
+		TokenHash:      helpers.HashToken(token),
+		ExpiresAt:      time.Now().Add(values.InvitationTTLHours * time.Hour),
+	}
+	if err := s.repo.CreateInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	// The token only reaches the invited email, the inviter can't accept in their place
+	err = s.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: fmt.Sprintf("You're invited to join %s", organization.Name),
+		Body: fmt.Sprintf("You've been invited to join %s as %s.\n\nSign in with this email and accept the invitation with the token %s\n\nIt expires in %d hours.",
+			organization.Name, invitation.Role, token, values.InvitationTTLHours),
+	})
+	if err != nil {
+		logrus.Errorf("Error sending invitation: %s", err)
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (s *organizationsService) GetInvitations(ctx context.Context, input *requests.GetInvitationsRequest) ([]*entities.OrganizationInvitation, error) {
+	if err := s.checkOrganizationAccess(ctx, input.ID, input.UserID, input.Role, values.ActionRead, values.OrganizationPermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetPendingInvitations(ctx, input.ID)
+}
+
+// AcceptInvitation adds the user to the organization of the invitation, which must have been sent to their email
+func (s *organizationsService) AcceptInvitation(ctx context.Context, input *requests.AcceptInvitationRequest) (*entities.Organization, error) {
+	invitation, err := s.repo.GetInvitationByToken(ctx, helpers.HashToken(input.Body.Token))
+	if err != nil {
+		return nil, err
+	}
+	if invitation.AcceptedAt != nil || invitation.IsExpired(time.Now()) {
+		return nil, domainErrors.ErrInvitationExpired
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, domainErrors.ErrInvitationEmailMismatch
+	}
+
+	if err := s.repo.AcceptInvitation(ctx, invitation.ID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	organization, err := s.repo.GetOrganizationByID(ctx, invitation.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	organization.Role = invitation.Role
+	return organization, nil
+}
+
+func (s *organizationsService) UpdateMemberRole(ctx context.Context, input *requests.UpdateMemberRoleRequest) (*entities.OrganizationMember, error) {
+	if err := s.checkOrganizationAccess(ctx, input.ID, input.UserID, input.Role, values.ActionUpdate, values.OrganizationPermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	if !entities.IsOrganizationRole(input.Body.Role) || input.Body.Role == values.OrganizationRoleOwner {
+		return nil, domainErrors.ErrInvalidOrganizationRole
+	}
+
+	member, err := s.repo.GetMember(ctx, input.ID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == values.OrganizationRoleOwner {
+		return nil, domainErrors.ErrOrganizationOwnerRequired
+	}
+
+	if err := s.repo.UpdateMemberRole(ctx, input.ID, input.MemberID, input.Body.Role); err != nil {
+		return nil, err
+	}
+
+	member.Role = input.Body.Role
+	return member, nil
+}
+
+// RemoveMember takes a member out of the organization, members can also leave on their own
+func (s *organizationsService) RemoveMember(ctx context.Context, input *requests.RemoveMemberRequest) error {
+	if input.MemberID != input.UserID {
+		if err := s.checkOrganizationAccess(ctx, input.ID, input.UserID, input.Role, values.ActionUpdate, values.OrganizationPermissionManageMembers); err != nil {
+			return err
+		}
+	}
+
+	member, err := s.repo.GetMember(ctx, input.ID, input.MemberID)
+	if err != nil {
+		return err
+	}
+	if member.Role == values.OrganizationRoleOwner {
+		return domainErrors.ErrOrganizationOwnerRequired
+	}
+
+	return s.repo.RemoveMember(ctx, input.ID, input.MemberID)
+}
+
+// checkOrganizationAccess allows roles with the action on any organization, and the members whose
+// organization role grants the permission
+func (s *organizationsService) checkOrganizationAccess(ctx context.Context, organizationID, userID, role, action, permission string) error {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, role, values.ResourceOrganizations, action)
+	if err != nil {
+		return err
+	}
+
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckOrganizationPermission(ctx, organizationID, userID, permission); err != nil {
+			return types.ErrNotAuthorized
+		}
+	}
+
+	return nil
+}
+
+// eventOrganization picks the organization a new event or series belongs to. Organizers, who create them
+// in their own scope, must be allowed to manage events in it and default to the first organization they own.
+// Roles creating them in any scope may leave it empty.
+func eventOrganization(ctx context.Context, organizationsRepo repository.OrganizationsRepository, commonRepo repository.CommonRepository, organizationID, userID, scope string) (string, error) {
+	if organizationID == "" {
+		if scope != values.ScopeOwn {
+			return "", nil
+		}
+		organization, err := organizationsRepo.GetDefaultOrganization(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		return organization.ID, nil
+	}
+
+	if scope == values.ScopeOwn {
+		if err := commonRepo.CheckOrganizationPermission(ctx, organizationID, userID, values.OrganizationPermissionManageEvents); err != nil {
+			return "", types.ErrNotAuthorized
+		}
+		return organizationID, nil
+	}
+
+	if _, err := organizationsRepo.GetOrganizationByID(ctx, organizationID); err != nil {
+		return "", err
+	}
+	return organizationID, nil
+}
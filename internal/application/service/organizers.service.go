@@ -0,0 +1,91 @@
+// internal/application/service/organizers.service.go
+package service
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type Organizers interface {
+	GetOrganizers(ctx context.Context, input *requests.GetOrganizersRequest) ([]*entities.User, error)
+	ApproveOrganizer(ctx context.Context, input *requests.ApproveOrganizerRequest) (*entities.User, error)
+	RejectOrganizer(ctx context.Context, input *requests.RejectOrganizerRequest) (*entities.User, error)
+	SuspendOrganizer(ctx context.Context, input *requests.SuspendOrganizerRequest) (*entities.User, error)
+}
+
+type organizersService struct {
+	repo     repository.UsersRepository
+	sessions Sessions
+	policy   Policy
+}
+
+func NewOrganizersService(repo repository.UsersRepository, sessions Sessions, policy Policy) *organizersService {
+	return &organizersService{
+		repo:     repo,
+		sessions: sessions,
+		policy:   policy,
+	}
+}
+
+func (s *organizersService) GetOrganizers(ctx context.Context, input *requests.GetOrganizersRequest) ([]*entities.User, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceOrganizers, values.ActionRead, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetUsers(ctx, values.OrganizerRole, input.Status)
+}
+
+func (s *organizersService) ApproveOrganizer(ctx context.Context, input *requests.ApproveOrganizerRequest) (*entities.User, error) {
+	return s.changeOrganizerStatus(ctx, input.ID, input.Role, values.UserStatusActive, "")
+}
+
+func (s *organizersService) RejectOrganizer(ctx context.Context, input *requests.RejectOrganizerRequest) (*entities.User, error) {
+	return s.changeOrganizerStatus(ctx, input.ID, input.Role, values.UserStatusRejected, input.Body.Reason)
+}
+
+// SuspendOrganizer blocks an approved organizer, the sales of their events stop and they're
+// signed out everywhere. Paid tickets stay valid, their events stay visible but closed for sales.
+func (s *organizersService) SuspendOrganizer(ctx context.Context, input *requests.SuspendOrganizerRequest) (*entities.User, error) {
+	organizer, err := s.changeOrganizerStatus(ctx, input.ID, input.Role, values.UserStatusSuspended, input.Body.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessions.RevokeUserSessions(ctx, organizer.ID); err != nil {
+		return nil, err
+	}
+	return organizer, nil
+}
+
+func (s *organizersService) changeOrganizerStatus(ctx context.Context, organizerID, role, status, reason string) (*entities.User, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, role, values.ResourceOrganizers, values.ActionReview, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	organizer, err := s.repo.GetByID(ctx, organizerID)
+	if err != nil {
+		return nil, domainErrors.ErrOrganizerNotFound
+	}
+	if organizer.Role != values.OrganizerRole {
+		return nil, domainErrors.ErrOrganizerNotFound
+	}
+
+	if !entities.CanTransitionOrganizer(organizer.Status, status) {
+		return nil, domainErrors.ErrInvalidOrganizerTransition
+	}
+
+	if err := s.repo.UpdateOrganizerStatus(ctx, organizerID, status, reason); err != nil {
+		return nil, err
+	}
+
+	organizer.Status = status
+	organizer.StatusReason = reason
+	return organizer, nil
+}
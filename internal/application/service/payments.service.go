@@ -0,0 +1,457 @@
+// internal/application/service/payments.service.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Payments interface {
+	Checkout(ctx context.Context, input *requests.CheckoutRequest) (*entities.Payment, error)
+	ConfirmPayment(ctx context.Context, input *requests.ConfirmPaymentRequest) (*entities.Payment, error)
+	GetPaymentByID(ctx context.Context, input *requests.GetPaymentByIDRequest) (*entities.Payment, error)
+	GetUserPayments(ctx context.Context, input *requests.GetUserPaymentsRequest) ([]*entities.Payment, error)
+	HandleWebhook(ctx context.Context, input *requests.PaymentWebhookRequest) error
+	RequestRefund(ctx context.Context, input *requests.RequestRefundRequest) (*entities.Refund, error)
+	RefundEventTickets(ctx context.Context, eventID string) ([]*entities.Refund, error)
+	RefundPendingTickets(ctx context.Context) ([]*entities.Refund, error)
+	GetEventRefunds(ctx context.Context, input *requests.GetEventRefundsRequest) ([]*entities.Refund, error)
+	GetUserRefunds(ctx context.Context, input *requests.GetUserRefundsRequest) ([]*entities.Refund, error)
+}
+
+// webhookPaymentStatuses maps provider webhook events onto our payment statuses.
+var webhookPaymentStatuses = map[string]string{
+	payments.EventPaymentSucceeded: values.PaymentStatusCompleted,
+	payments.EventPaymentFailed:    values.PaymentStatusFailed,
+	payments.EventPaymentRefunded:  values.PaymentStatusRefunded,
+}
+
+type paymentsService struct {
+	repo          repository.PaymentsRepository
+	ticketsRepo   repository.TicketsRepository
+	refundsRepo   repository.RefundsRepository
+	eventsRepo    repository.EventsRepository
+	commonRepo    repository.CommonRepository
+	provider      payments.Provider
+	webhookSecret string
+	policy        Policy
+}
+
+func NewPaymentsService(
+	repo repository.PaymentsRepository,
+	ticketsRepo repository.TicketsRepository,
+	refundsRepo repository.RefundsRepository,
+	eventsRepo repository.EventsRepository,
+	commonRepo repository.CommonRepository,
+	provider payments.Provider,
+	webhookSecret string,
+	policy Policy,
+) *paymentsService {
+	return &paymentsService{
+		repo:          repo,
+		ticketsRepo:   ticketsRepo,
+		refundsRepo:   refundsRepo,
+		eventsRepo:    eventsRepo,
+		commonRepo:    commonRepo,
+		provider:      provider,
+		webhookSecret: webhookSecret,
+		policy:        policy,
+	}
+}
+
+// Checkout creates a pending payment with the provider for the given reserved tickets. The
+// payment is stored first, holding the tickets, so a provider payment is never left without
+// its local record; the tickets are given back when the provider step fails.
+func (s *paymentsService) Checkout(ctx context.Context, input *requests.CheckoutRequest) (*entities.Payment, error) {
+	var amount float64
+	seen := make(map[string]bool, len(input.Body.TicketIDs))
+	for _, ticketID := range input.Body.TicketIDs {
+		if seen[ticketID] {
+			return nil, domainErrors.ErrTicketNotPayable
+		}
+		seen[ticketID] = true
+
+		ticket, err := s.ticketsRepo.GetTicketByID(ctx, ticketID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the owner's reserved tickets that aren't already being paid for
+		if ticket.UserID != input.UserID {
+			return nil, types.ErrNotAuthorized
+		}
+		if ticket.Status != values.TicketStatusReserved || ticket.PaymentID != "" {
+			return nil, domainErrors.ErrTicketNotPayable
+		}
+
+		amount += ticket.Price
+	}
+
+	payment := &entities.Payment{
+		Amount:   amount,
+		Currency: values.PaymentCurrency,
+	}
+	if err := s.repo.CreatePayment(ctx, input.UserID, input.Body.TicketIDs, payment); err != nil {
+		return nil, err
+	}
+
+	providerPayment, err := s.provider.CreatePayment(ctx, payments.CreatePaymentInput{
+		UserID:      input.UserID,
+		Amount:      amount,
+		Currency:    values.PaymentCurrency,
+		Description: fmt.Sprintf("%d ticket(s)", len(input.Body.TicketIDs)),
+		Reference:   payment.ID,
+	})
+	if err != nil {
+		logrus.Errorf("Error creating provider payment: %s", err)
+		s.abandonCheckout(ctx, payment)
+		return nil, err
+	}
+
+	// Nobody got the checkout URL when this fails, the provider payment can't be charged
+	// and its reference leads back to the failed payment
+	if err := s.repo.AttachProviderPayment(ctx, payment.ID, providerPayment.ID, providerPayment.CheckoutURL); err != nil {
+		logrus.Errorf("Error attaching provider payment %s to payment %s: %s", providerPayment.ID, payment.ID, err)
+		s.abandonCheckout(ctx, payment)
+		return nil, err
+	}
+
+	payment.ProviderPaymentID = providerPayment.ID
+	payment.CheckoutURL = providerPayment.CheckoutURL
+	return payment, nil
+}
+
+// abandonCheckout fails a payment whose checkout couldn't start, its tickets can be checked
+// out again. They're released by the expiry job anyway when this fails too.
+func (s *paymentsService) abandonCheckout(ctx context.Context, payment *entities.Payment) {
+	if err := s.repo.FailPayment(ctx, payment.ID, nil); err != nil {
+		logrus.Errorf("Error failing abandoned payment %s: %s", payment.ID, err)
+	}
+}
+
+// ConfirmPayment syncs a pending payment with the provider and settles the tickets once it completes.
+func (s *paymentsService) ConfirmPayment(ctx context.Context, input *requests.ConfirmPaymentRequest) (*entities.Payment, error) {
+	payment, err := s.GetPaymentByID(ctx, &requests.GetPaymentByIDRequest{
+		PaymentID: input.PaymentID,
+		UserID:    input.UserID,
+		Role:      input.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A checkout that never reached the provider has nothing to sync
+	if payment.Status != values.PaymentStatusPending || payment.ProviderPaymentID == "" {
+		return payment, nil
+	}
+
+	providerPayment, err := s.provider.GetPayment(ctx, payment.ProviderPaymentID)
+	if err != nil {
+		logrus.Errorf("Error getting provider payment: %s", err)
+		return nil, err
+	}
+
+	switch providerPayment.Status {
+	case payments.StatusSucceeded:
+		err = s.applyPaymentStatus(ctx, payment, values.PaymentStatusCompleted, nil)
+	case payments.StatusFailed:
+		err = s.applyPaymentStatus(ctx, payment, values.PaymentStatusFailed, nil)
+	default:
+		return payment, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetPaymentByID(ctx, payment.ID)
+}
+
+func (s *paymentsService) GetPaymentByID(ctx context.Context, input *requests.GetPaymentByIDRequest) (*entities.Payment, error) {
+	payment, err := s.repo.GetPaymentByID(ctx, input.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check permissions
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourcePayments, values.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+	if scope == values.ScopeOwn {
+		if err := s.repo.ValidatePaymentOwnership(ctx, input.PaymentID, input.UserID); err != nil {
+			if errors.Is(err, domainErrors.ErrPaymentNotFound) {
+				return nil, types.ErrNotAuthorized
+			}
+			return nil, err
+		}
+	}
+
+	return payment, nil
+}
+
+func (s *paymentsService) GetUserPayments(ctx context.Context, input *requests.GetUserPaymentsRequest) ([]*entities.Payment, error) {
+	return s.repo.GetPaymentsByUser(ctx, input.UserID, input.Status)
+}
+
+// HandleWebhook applies a signed provider callback. The event is recorded along with the
+// status change it causes, so a retried delivery is acknowledged without being applied again.
+func (s *paymentsService) HandleWebhook(ctx context.Context, input *requests.PaymentWebhookRequest) error {
+	event, err := payments.ParseWebhookEvent(s.webhookSecret, input.Payload, input.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domainErrors.ErrInvalidWebhook, err)
+	}
+
+	webhookEvent := &entities.PaymentWebhookEvent{
+		ID:                event.ID,
+		Type:              event.Type,
+		ProviderPaymentID: event.PaymentID,
+	}
+
+	status, ok := webhookPaymentStatuses[event.Type]
+	if !ok {
+		logrus.Warnf("Ignoring unsupported payment webhook event type %s", event.Type)
+		err = s.repo.SaveWebhookEvent(ctx, webhookEvent)
+	} else {
+		var payment *entities.Payment
+		payment, err = s.repo.GetPaymentByProviderID(ctx, event.PaymentID)
+		if err != nil {
+			return err
+		}
+		if status == values.PaymentStatusRefunded {
+			err = s.repo.RefundPayment(ctx, payment.ID, toProviderRefund(event.Refund), webhookEvent)
+		} else {
+			err = s.applyPaymentStatus(ctx, payment, status, webhookEvent)
+		}
+	}
+
+	if errors.Is(err, domainErrors.ErrWebhookProcessed) {
+		logrus.Infof("Payment webhook event %s already processed", event.ID)
+		return nil
+	}
+	return err
+}
+
+// applyPaymentStatus moves a payment and its tickets to the completed or failed status. The
+// repository checks the transition against the payment's current status, under lock, and
+// ignores the ones that aren't valid. The event is recorded with the change when it's given.
+func (s *paymentsService) applyPaymentStatus(ctx context.Context, payment *entities.Payment, status string, event *entities.PaymentWebhookEvent) error {
+	if status == values.PaymentStatusCompleted {
+		return s.completePayment(ctx, payment, event)
+	}
+	return s.repo.FailPayment(ctx, payment.ID, event)
+}
+
+// RequestRefund refunds a paid ticket for its owner according to the event's refund policy.
+func (s *paymentsService) RequestRefund(ctx context.Context, input *requests.RequestRefundRequest) (*entities.Refund, error) {
+	ticket, err := s.ticketsRepo.GetTicketByID(ctx, input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify permissions
+	if err := s.ticketsRepo.ValidateTicketOwnership(ctx, input.TicketID, input.UserID); err != nil {
+		return nil, types.ErrNotAuthorized
+	}
+
+	if ticket.Status != values.TicketStatusPaid {
+		return nil, domainErrors.ErrInvalidTicketStatus
+	}
+
+	event, err := s.eventsRepo.GetEventByID(ctx, ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the event's refund policy
+	if event.RefundPercent <= 0 {
+		return nil, domainErrors.ErrRefundNotAllowed
+	}
+	deadline := event.StartsAt.Add(-time.Duration(event.RefundDeadlineHours) * time.Hour)
+	if time.Now().After(deadline) {
+		return nil, domainErrors.ErrRefundNotAllowed
+	}
+
+	amount := math.Round(ticket.Price*float64(event.RefundPercent)) / 100
+
+	return s.refundTicket(ctx, ticket, amount, values.RefundReasonUserRequested)
+}
+
+// RefundEventTickets fully refunds the paid tickets of a cancelled event that wait for their refund.
+// Tickets that fail to refund are recorded as failed refunds and don't stop the others.
+func (s *paymentsService) RefundEventTickets(ctx context.Context, eventID string) ([]*entities.Refund, error) {
+	tickets, err := s.ticketsRepo.GetRefundPendingTickets(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.refundCancelledTickets(ctx, tickets), nil
+}
+
+// RefundPendingTickets retries the refunds of every cancelled event that didn't go through yet.
+func (s *paymentsService) RefundPendingTickets(ctx context.Context) ([]*entities.Refund, error) {
+	tickets, err := s.ticketsRepo.GetRefundPendingTickets(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.refundCancelledTickets(ctx, tickets), nil
+}
+
+func (s *paymentsService) GetEventRefunds(ctx context.Context, input *requests.GetEventRefundsRequest) ([]*entities.Refund, error) {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceRefunds, values.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	// For organizer, verify their role in the event's organization covers tickets
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckEventPermission(ctx, input.EventID, input.OrganizerID, values.OrganizationPermissionManageTickets); err != nil {
+			return nil, types.ErrNotAuthorized
+		}
+	}
+
+	return s.refundsRepo.GetRefundsByEvent(ctx, input.EventID, input.Status)
+}
+
+func (s *paymentsService) GetUserRefunds(ctx context.Context, input *requests.GetUserRefundsRequest) ([]*entities.Refund, error) {
+	return s.refundsRepo.GetRefundsByUser(ctx, input.UserID, input.Status)
+}
+
+// refundCancelledTickets fully refunds tickets of cancelled events. A ticket stays marked for
+// its refund until one completes, so a refund that failed is tried again on the next run.
+func (s *paymentsService) refundCancelledTickets(ctx context.Context, tickets []*entities.Ticket) []*entities.Refund {
+	var refunds []*entities.Refund
+	for _, ticket := range tickets {
+		refund, err := s.refundTicket(ctx, ticket, ticket.Price, values.RefundReasonEventCancelled)
+		if errors.Is(err, domainErrors.ErrRefundAlreadyRequested) {
+			continue
+		}
+		if err != nil {
+			logrus.Errorf("Error refunding ticket %s: %s", ticket.ID, err)
+			continue
+		}
+		refunds = append(refunds, refund)
+	}
+
+	return refunds
+}
+
+// refundTicket records a refund for the ticket and executes it with the provider.
+// A refund rejected by the provider is returned with the failed status.
+func (s *paymentsService) refundTicket(ctx context.Context, ticket *entities.Ticket, amount float64, reason string) (*entities.Refund, error) {
+	if ticket.PaymentID == "" {
+		return nil, domainErrors.ErrRefundNotAllowed
+	}
+
+	payment, err := s.repo.GetPaymentByID(ctx, ticket.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := &entities.Refund{
+		TicketID:  ticket.ID,
+		PaymentID: payment.ID,
+		EventID:   ticket.EventID,
+		UserID:    ticket.UserID,
+		Amount:    amount,
+		Reason:    reason,
+	}
+	if err := s.refundsRepo.CreateRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+
+	providerRefund, err := s.provider.CreateRefund(ctx, payments.CreateRefundInput{
+		PaymentID: payment.ProviderPaymentID,
+		Amount:    amount,
+		Reason:    reason,
+		Reference: refund.ID,
+	})
+	if err != nil {
+		logrus.Errorf("Error refunding ticket %s with provider: %s", ticket.ID, err)
+		if err := s.refundsRepo.FailRefund(ctx, refund.ID, err.Error()); err != nil {
+			return nil, err
+		}
+		refund.Status = values.RefundStatusFailed
+		refund.FailureReason = err.Error()
+		return refund, nil
+	}
+
+	if err := s.refundsRepo.CompleteRefund(ctx, refund.ID, providerRefund.ID); err != nil {
+		return nil, err
+	}
+	refund.Status = values.RefundStatusCompleted
+	refund.ProviderRefundID = providerRefund.ID
+
+	return refund, nil
+}
+
+// completePayment marks the payment completed and every ticket it covers as paid. When the
+// tickets were released before the charge came in, the customer gets the charge back. The
+// event is only recorded once the refund went through, so a failed one is retried with it.
+func (s *paymentsService) completePayment(ctx context.Context, payment *entities.Payment, event *entities.PaymentWebhookEvent) error {
+	err := s.repo.CompletePayment(ctx, payment.ID, time.Now(), event)
+	if errors.Is(err, domainErrors.ErrPaymentReleased) {
+		logrus.Warnf("Payment %s was charged after its tickets were released, refunding it", payment.ID)
+		if err := s.refundReleasedPayment(ctx, payment); err != nil {
+			return err
+		}
+		if event == nil {
+			return nil
+		}
+		return s.repo.SaveWebhookEvent(ctx, event)
+	}
+	if err != nil && !errors.Is(err, domainErrors.ErrWebhookProcessed) {
+		logrus.Errorf("Error completing payment %s: %s", payment.ID, err)
+	}
+	return err
+}
+
+// refundReleasedPayment refunds what's left of the charge of a payment that couldn't complete.
+// Its payment.refunded callback then moves it to refunded.
+func (s *paymentsService) refundReleasedPayment(ctx context.Context, payment *entities.Payment) error {
+	providerPayment, err := s.provider.GetPayment(ctx, payment.ProviderPaymentID)
+	if err != nil {
+		logrus.Errorf("Error getting provider payment: %s", err)
+		return err
+	}
+
+	// A retried callback finds the charge refunded already
+	if providerPayment.Status != payments.StatusSucceeded {
+		return nil
+	}
+
+	_, err = s.provider.CreateRefund(ctx, payments.CreateRefundInput{
+		PaymentID: payment.ProviderPaymentID,
+		Amount:    providerPayment.Amount - providerPayment.RefundedAmount,
+		Reason:    values.RefundReasonReleased,
+	})
+	if err != nil {
+		logrus.Errorf("Error refunding payment %s with provider: %s", payment.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func toProviderRefund(refund *payments.WebhookRefund) *entities.ProviderRefund {
+	return &entities.ProviderRefund{
+		ID:            refund.ID,
+		RefundID:      refund.Reference,
+		Amount:        refund.Amount,
+		RefundedTotal: refund.RefundedTotal,
+	}
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+type checkoutTicketsStubRepository struct {
+	repository.TicketsRepository
+	mu      sync.Mutex
+	tickets map[string]*entities.Ticket
+}
+
+func (r *checkoutTicketsStubRepository) GetTicketByID(ctx context.Context, ticketID string) (*entities.Ticket, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ticket, ok := r.tickets[ticketID]
+	if !ok {
+		return nil, domainErrors.ErrTicketNotFound
+	}
+	copied := *ticket
+	return &copied, nil
+}
+
+func (r *checkoutTicketsStubRepository) UpdateTicketPayment(ctx context.Context, ticketID string, paidAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ticket, ok := r.tickets[ticketID]
+	if !ok || ticket.Status != values.TicketStatusReserved {
+		return domainErrors.ErrTicketNotPayable
+	}
+	ticket.Status = values.TicketStatusPaid
+	ticket.PaidAt = paidAt
+	return nil
+}
+
+// checkoutPaymentsStubRepository follows the semantics of the postgres repository for the
+// payments of a checkout, its tickets live in the tickets stub
+type checkoutPaymentsStubRepository struct {
+	repository.PaymentsRepository
+	mu       sync.Mutex
+	tickets  *checkoutTicketsStubRepository
+	payments map[string]*entities.Payment
+	events   map[string]bool
+}
+
+func (r *checkoutPaymentsStubRepository) CreatePayment(ctx context.Context, userID string, ticketIDs []string, payment *entities.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tickets.mu.Lock()
+	defer r.tickets.mu.Unlock()
+
+	for _, ticketID := range ticketIDs {
+		ticket, ok := r.tickets.tickets[ticketID]
+		if !ok || ticket.UserID != userID || ticket.Status != values.TicketStatusReserved || ticket.PaymentID != "" {
+			return domainErrors.ErrTicketNotPayable
+		}
+	}
+
+	payment.ID = uuid.NewString()
+	payment.UserID = userID
+	payment.Status = values.PaymentStatusPending
+	for _, ticketID := range ticketIDs {
+		r.tickets.tickets[ticketID].PaymentID = payment.ID
+	}
+	stored := *payment
+	r.payments[payment.ID] = &stored
+	return nil
+}
+
+func (r *checkoutPaymentsStubRepository) AttachProviderPayment(ctx context.Context, paymentID, providerPaymentID, checkoutURL string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	payment, ok := r.payments[paymentID]
+	if !ok || payment.ProviderPaymentID != "" {
+		return domainErrors.ErrPaymentNotFound
+	}
+	payment.ProviderPaymentID = providerPaymentID
+	payment.CheckoutURL = checkoutURL
+	return nil
+}
+
+func (r *checkoutPaymentsStubRepository) GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entities.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, payment := range r.payments {
+		if payment.ProviderPaymentID == providerPaymentID {
+			copied := *payment
+			return &copied, nil
+		}
+	}
+	return nil, domainErrors.ErrPaymentNotFound
+}
+
+func (r *checkoutPaymentsStubRepository) CompletePayment(ctx context.Context, paymentID string, paidAt time.Time, event *entities.PaymentWebhookEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if event != nil && r.events[event.ID] {
+		return domainErrors.ErrWebhookProcessed
+	}
+	payment := r.payments[paymentID]
+	if payment.Status != values.PaymentStatusPending {
+		return domainErrors.ErrPaymentReleased
+	}
+
+	for _, ticket := range r.paymentTickets(paymentID) {
+		if err := r.tickets.UpdateTicketPayment(ctx, ticket.ID, paidAt); err != nil {
+			return err
+		}
+	}
+	payment.Status = values.PaymentStatusCompleted
+	if event != nil {
+		r.events[event.ID] = true
+	}
+	return nil
+}
+
+func (r *checkoutPaymentsStubRepository) FailPayment(ctx context.Context, paymentID string, event *entities.PaymentWebhookEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if event != nil && r.events[event.ID] {
+		return domainErrors.ErrWebhookProcessed
+	}
+	payment := r.payments[paymentID]
+	if payment.Status == values.PaymentStatusPending {
+		payment.Status = values.PaymentStatusFailed
+		for _, ticket := range r.paymentTickets(paymentID) {
+			r.tickets.mu.Lock()
+			r.tickets.tickets[ticket.ID].PaymentID = ""
+			r.tickets.mu.Unlock()
+		}
+	}
+	if event != nil {
+		r.events[event.ID] = true
+	}
+	return nil
+}
+
+func (r *checkoutPaymentsStubRepository) paymentTickets(paymentID string) []entities.Ticket {
+	r.tickets.mu.Lock()
+	defer r.tickets.mu.Unlock()
+	var tickets []entities.Ticket
+	for _, ticket := range r.tickets.tickets {
+		if ticket.PaymentID == paymentID {
+			tickets = append(tickets, *ticket)
+		}
+	}
+	return tickets
+}
+
+// failingProvider can't create payments
+type failingProvider struct {
+	payments.Provider
+}
+
+func (p *failingProvider) CreatePayment(ctx context.Context, input payments.CreatePaymentInput) (*payments.Payment, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+type checkoutFixture struct {
+	service  *paymentsService
+	tickets  *checkoutTicketsStubRepository
+	payments *checkoutPaymentsStubRepository
+}
+
+// newCheckoutFixture reserves a ticket of each price for user-1
+func newCheckoutFixture(provider payments.Provider, prices ...float64) *checkoutFixture {
+	tickets := &checkoutTicketsStubRepository{tickets: make(map[string]*entities.Ticket)}
+	for _, price := range prices {
+		id := uuid.NewString()
+		tickets.tickets[id] = &entities.Ticket{ID: id, UserID: "user-1", Status: values.TicketStatusReserved, Price: price}
+	}
+	paymentsRepo := &checkoutPaymentsStubRepository{
+		tickets:  tickets,
+		payments: make(map[string]*entities.Payment),
+		events:   make(map[string]bool),
+	}
+
+	return &checkoutFixture{
+		service:  NewPaymentsService(paymentsRepo, tickets, nil, nil, nil, provider, testWebhookSecret, NewPolicyService(nil)),
+		tickets:  tickets,
+		payments: paymentsRepo,
+	}
+}
+
+func (f *checkoutFixture) ticketIDs() []string {
+	var ids []string
+	for id := range f.tickets.tickets {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestCheckoutPaidThroughWebhook(t *testing.T) {
+	ctx := context.Background()
+	provider := payments.NewFakeProvider()
+	fixture := newCheckoutFixture(provider, 10, 15.5)
+
+	// The provider delivers its callbacks to the service the way the webhook handler does
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+		err := fixture.service.HandleWebhook(r.Context(), &requests.PaymentWebhookRequest{
+			Payload:   payload,
+			Signature: r.Header.Get(payments.SignatureHeader),
+		})
+		if err != nil {
+			t.Errorf("HandleWebhook: %s", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	provider.SetWebhook(server.URL, testWebhookSecret)
+
+	payment, err := fixture.service.Checkout(ctx, &requests.CheckoutRequest{
+		Body:   requests.CheckoutRequestBody{TicketIDs: fixture.ticketIDs()},
+		UserID: "user-1",
+	})
+	if err != nil {
+		t.Fatalf("Checkout: %s", err)
+	}
+	if payment.ProviderPaymentID == "" || payment.CheckoutURL == "" || payment.Amount != 25.5 {
+		t.Fatalf("payment = %+v, want a provider payment of 25.5", payment)
+	}
+
+	providerPayment, err := provider.GetPayment(ctx, payment.ProviderPaymentID)
+	if err != nil {
+		t.Fatalf("GetPayment: %s", err)
+	}
+	if providerPayment.Reference != payment.ID {
+		t.Errorf("provider payment reference = %q, want %q", providerPayment.Reference, payment.ID)
+	}
+
+	if err := provider.Succeed(ctx, payment.ProviderPaymentID); err != nil {
+		t.Fatalf("Succeed: %s", err)
+	}
+
+	if status := fixture.payments.payments[payment.ID].Status; status != values.PaymentStatusCompleted {
+		t.Errorf("payment status = %q, want %q", status, values.PaymentStatusCompleted)
+	}
+	for _, ticket := range fixture.tickets.tickets {
+		if ticket.Status != values.TicketStatusPaid || ticket.PaidAt.IsZero() || ticket.PaymentID != payment.ID {
+			t.Errorf("ticket %s = %+v, want paid with payment %s", ticket.ID, ticket, payment.ID)
+		}
+	}
+}
+
+func TestCheckoutGivesTicketsBackWhenProviderFails(t *testing.T) {
+	fixture := newCheckoutFixture(&failingProvider{}, 10)
+
+	_, err := fixture.service.Checkout(context.Background(), &requests.CheckoutRequest{
+		Body:   requests.CheckoutRequestBody{TicketIDs: fixture.ticketIDs()},
+		UserID: "user-1",
+	})
+	if err == nil {
+		t.Fatal("Checkout succeeded without a provider payment")
+	}
+
+	for _, payment := range fixture.payments.payments {
+		if payment.Status != values.PaymentStatusFailed {
+			t.Errorf("payment status = %q, want %q", payment.Status, values.PaymentStatusFailed)
+		}
+	}
+	for _, ticket := range fixture.tickets.tickets {
+		if ticket.Status != values.TicketStatusReserved || ticket.PaymentID != "" {
+			t.Errorf("ticket %s = %+v, want reserved and free to check out again", ticket.ID, ticket)
+		}
+	}
+}
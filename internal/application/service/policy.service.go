@@ -0,0 +1,105 @@
+// internal/application/service/policy.service.go
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// Policy decides what the role of a user may do, the permission middleware and the services
+// ask it before acting
+type Policy interface {
+	// Scope returns the widest scope the role may take the action on the resource in,
+	// types.ErrNotAuthorized when it may not take it at all
+	Scope(ctx context.Context, role, resource, action string) (string, error)
+	// Authorize fails with types.ErrNotAuthorized unless the role may take the action in the scope
+	Authorize(ctx context.Context, role, resource, action, scope string) error
+	// Role returns the built-in or custom role with the name
+	Role(ctx context.Context, name string) (*entities.Role, error)
+	// Forget drops a custom role from the cache once it changed
+	Forget(name string)
+}
+
+type cachedRole struct {
+	role      *entities.Role
+	expiresAt time.Time
+}
+
+type policyService struct {
+	repo repository.RolesRepository
+
+	mu    sync.RWMutex
+	roles map[string]cachedRole // Custom roles read lately, the built-in ones aren't cached
+}
+
+func NewPolicyService(repo repository.RolesRepository) *policyService {
+	return &policyService{
+		repo:  repo,
+		roles: make(map[string]cachedRole),
+	}
+}
+
+func (s *policyService) Scope(ctx context.Context, role, resource, action string) (string, error) {
+	granted, err := s.Role(ctx, role)
+	if err != nil {
+		// Tokens may outlive the custom role they carry
+		if errors.Is(err, domainErrors.ErrRoleNotFound) {
+			return "", types.ErrNotAuthorized
+		}
+		return "", err
+	}
+
+	scope := granted.Scope(resource, action)
+	if scope == "" {
+		return "", types.ErrNotAuthorized
+	}
+	return scope, nil
+}
+
+func (s *policyService) Authorize(ctx context.Context, role, resource, action, scope string) error {
+	granted, err := s.Scope(ctx, role, resource, action)
+	if err != nil {
+		return err
+	}
+	if !entities.ScopeCovers(granted, scope) {
+		return types.ErrNotAuthorized
+	}
+	return nil
+}
+
+func (s *policyService) Role(ctx context.Context, name string) (*entities.Role, error) {
+	if role, ok := entities.BuiltInRole(name); ok {
+		return role, nil
+	}
+
+	s.mu.RLock()
+	cached, ok := s.roles[name]
+	s.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.role, nil
+	}
+
+	role, err := s.repo.GetRoleByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.roles[name] = cachedRole{role: role, expiresAt: time.Now().Add(values.RoleCacheTTLSeconds * time.Second)}
+	s.mu.Unlock()
+	return role, nil
+}
+
+func (s *policyService) Forget(name string) {
+	s.mu.Lock()
+	delete(s.roles, name)
+	s.mu.Unlock()
+}
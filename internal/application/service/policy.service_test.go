@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type rolesStubRepository struct {
+	repository.RolesRepository
+	roles map[string]*entities.Role
+}
+
+func (r *rolesStubRepository) GetRoleByName(ctx context.Context, name string) (*entities.Role, error) {
+	role, ok := r.roles[name]
+	if !ok {
+		return nil, domainErrors.ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// moderatorRole reviews any event and reads the organizers
+var moderatorRole = &entities.Role{Name: "moderator", Permissions: []entities.Permission{
+	{Resource: values.ResourceEvents, Action: values.ActionReview, Scope: values.ScopeAny},
+	{Resource: values.ResourceEvents, Action: values.ActionRead, Scope: values.ScopeAny},
+	{Resource: values.ResourceOrganizers, Action: values.PermissionWildcard, Scope: values.ScopeAny},
+	{Resource: values.ResourceUsers, Action: values.ActionUpdate, Scope: values.ScopeAny},
+}}
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy := NewPolicyService(&rolesStubRepository{roles: map[string]*entities.Role{moderatorRole.Name: moderatorRole}})
+
+	tests := []struct {
+		name      string
+		role      string
+		resource  string
+		action    string
+		scope     string
+		wantScope string
+		wantErr   error
+	}{
+		{"user on own tickets", values.UserRole, values.ResourceTickets, values.ActionRead, values.ScopeOwn, values.ScopeOwn, nil},
+		{"user on any tickets", values.UserRole, values.ResourceTickets, values.ActionRead, values.ScopeAny, values.ScopeOwn, types.ErrNotAuthorized},
+		{"user creating events", values.UserRole, values.ResourceEvents, values.ActionCreate, values.ScopeOwn, "", types.ErrNotAuthorized},
+		{"organizer on own events", values.OrganizerRole, values.ResourceEvents, values.ActionUpdate, values.ScopeOwn, values.ScopeOwn, nil},
+		{"organizer reviewing events", values.OrganizerRole, values.ResourceEvents, values.ActionReview, values.ScopeAny, "", types.ErrNotAuthorized},
+		{"admin on anything", values.AdminRole, values.ResourceRoles, values.ActionDelete, values.ScopeAny, values.ScopeAny, nil},
+		{"custom role", moderatorRole.Name, values.ResourceEvents, values.ActionReview, values.ScopeAny, values.ScopeAny, nil},
+		{"custom role with an action wildcard", moderatorRole.Name, values.ResourceOrganizers, values.ActionReview, values.ScopeAny, values.ScopeAny, nil},
+		{"custom role beyond its permissions", moderatorRole.Name, values.ResourceEvents, values.ActionDelete, values.ScopeOwn, "", types.ErrNotAuthorized},
+		{"unknown role", "deleted", values.ResourceTickets, values.ActionRead, values.ScopeOwn, "", types.ErrNotAuthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := policy.Scope(context.Background(), tt.role, tt.resource, tt.action)
+			if scope != tt.wantScope {
+				t.Errorf("Scope = %q, want %q", scope, tt.wantScope)
+			}
+			if tt.wantScope == "" && !errors.Is(err, types.ErrNotAuthorized) {
+				t.Errorf("Scope error = %v, want %v", err, types.ErrNotAuthorized)
+			}
+
+			err = policy.Authorize(context.Background(), tt.role, tt.resource, tt.action, tt.scope)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authorize error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// internal/application/service/roles.service.go
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type Roles interface {
+	GetRoles(ctx context.Context, input *requests.GetRolesRequest) ([]*entities.Role, error)
+	CreateRole(ctx context.Context, input *requests.CreateRoleRequest) (*entities.Role, error)
+	UpdateRole(ctx context.Context, input *requests.UpdateRoleRequest) (*entities.Role, error)
+	DeleteRole(ctx context.Context, input *requests.DeleteRoleRequest) error
+	AssignUserRole(ctx context.Context, input *requests.AssignUserRoleRequest) (*entities.User, error)
+}
+
+type rolesService struct {
+	repo      repository.RolesRepository
+	usersRepo repository.UsersRepository
+	sessions  Sessions
+	policy    Policy
+}
+
+func NewRolesService(repo repository.RolesRepository, usersRepo repository.UsersRepository, sessions Sessions, policy Policy) *rolesService {
+	return &rolesService{
+		repo:      repo,
+		usersRepo: usersRepo,
+		sessions:  sessions,
+		policy:    policy,
+	}
+}
+
+// GetRoles lists the built-in roles followed by the custom ones
+func (s *rolesService) GetRoles(ctx context.Context, input *requests.GetRolesRequest) ([]*entities.Role, error) {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceRoles, values.ActionRead, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	custom, err := s.repo.GetRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]*entities.Role{}, entities.BuiltInRoles()...), custom...), nil
+}
+
+func (s *rolesService) CreateRole(ctx context.Context, input *requests.CreateRoleRequest) (*entities.Role, error) {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceRoles, values.ActionCreate, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	name := strings.ToLower(strings.TrimSpace(input.Body.Name))
+	if !isRoleName(name) {
+		return nil, domainErrors.ErrInvalidRoleName
+	}
+	if _, ok := entities.BuiltInRole(name); ok {
+		return nil, domainErrors.ErrRoleExists
+	}
+
+	permissions, err := s.rolePermissions(ctx, input.Role, input.Body.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &entities.Role{
+		Name:        name,
+		Description: strings.TrimSpace(input.Body.Description),
+		Permissions: permissions,
+	}
+	if err := s.repo.CreateRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+func (s *rolesService) UpdateRole(ctx context.Context, input *requests.UpdateRoleRequest) (*entities.Role, error) {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceRoles, values.ActionUpdate, values.ScopeAny); err != nil {
+		return nil, err
+	}
+	if _, ok := entities.BuiltInRole(input.Name); ok {
+		return nil, domainErrors.ErrBuiltInRole
+	}
+
+	permissions, err := s.rolePermissions(ctx, input.Role, input.Body.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &entities.Role{
+		Name:        input.Name,
+		Description: strings.TrimSpace(input.Body.Description),
+		Permissions: permissions,
+	}
+	if err := s.repo.UpdateRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	s.policy.Forget(input.Name)
+	return role, nil
+}
+
+func (s *rolesService) DeleteRole(ctx context.Context, input *requests.DeleteRoleRequest) error {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceRoles, values.ActionDelete, values.ScopeAny); err != nil {
+		return err
+	}
+	if _, ok := entities.BuiltInRole(input.Name); ok {
+		return domainErrors.ErrBuiltInRole
+	}
+
+	if err := s.repo.DeleteRole(ctx, input.Name); err != nil {
+		return err
+	}
+
+	s.policy.Forget(input.Name)
+	return nil
+}
+
+// AssignUserRole gives a user a built-in or custom role. The caller must hold every permission
+// of both the new role and the one it replaces, and the user is signed out everywhere so the
+// old role's tokens stop working.
+func (s *rolesService) AssignUserRole(ctx context.Context, input *requests.AssignUserRoleRequest) (*entities.User, error) {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceUsers, values.ActionUpdate, values.ScopeAny); err != nil {
+		return nil, err
+	}
+
+	// Nobody changes their own role, so an admin can't lock everyone out by accident
+	if input.ID == input.UserID {
+		return nil, types.ErrNotAuthorized
+	}
+
+	role, err := s.policy.Role(ctx, input.Body.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGrantable(ctx, input.Role, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nobody takes a role away from a user who holds more than they do. A custom role
+	// that was deleted meanwhile grants nothing anymore.
+	current, err := s.policy.Role(ctx, user.Role)
+	if err != nil && !errors.Is(err, domainErrors.ErrRoleNotFound) {
+		return nil, err
+	}
+	if current != nil {
+		if err := s.checkGrantable(ctx, input.Role, current.Permissions); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.usersRepo.UpdateRole(ctx, user.ID, role.Name); err != nil {
+		return nil, err
+	}
+	if err := s.sessions.RevokeUserSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	user.Role = role.Name
+	return user, nil
+}
+
+// rolePermissions reads the permissions of a custom role, a role can only be given
+// permissions its author has
+func (s *rolesService) rolePermissions(ctx context.Context, authorRole string, raw []string) ([]entities.Permission, error) {
+	index := make(map[string]int, len(raw))
+	permissions := make([]entities.Permission, 0, len(raw))
+	for _, value := range raw {
+		permission, ok := entities.ParsePermission(value)
+		if !ok {
+			return nil, domainErrors.ErrInvalidPermission
+		}
+
+		// An action on a resource is granted once, in its widest scope
+		key := permission.Resource + ":" + permission.Action
+		if i, ok := index[key]; ok {
+			if permission.Scope == values.ScopeAny {
+				permissions[i].Scope = values.ScopeAny
+			}
+			continue
+		}
+		index[key] = len(permissions)
+		permissions = append(permissions, permission)
+	}
+
+	if err := s.checkGrantable(ctx, authorRole, permissions); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// checkGrantable makes sure the role holds every one of the permissions
+func (s *rolesService) checkGrantable(ctx context.Context, roleName string, permissions []entities.Permission) error {
+	role, err := s.policy.Role(ctx, roleName)
+	if err != nil {
+		return types.ErrNotAuthorized
+	}
+
+	for _, permission := range permissions {
+		if !entities.ScopeCovers(role.Scope(permission.Resource, permission.Action), permission.Scope) {
+			return types.ErrNotAuthorized
+		}
+	}
+	return nil
+}
+
+// isRoleName tells whether the name is lowercase letters, digits and underscores, starting with a letter
+func isRoleName(name string) bool {
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return false
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type roleUsersStubRepository struct {
+	repository.UsersRepository
+	users map[string]*entities.User
+}
+
+func (r *roleUsersStubRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	user, ok := r.users[userID]
+	if !ok {
+		return nil, domainErrors.ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *roleUsersStubRepository) UpdateRole(ctx context.Context, userID, role string) error {
+	r.users[userID].Role = role
+	return nil
+}
+
+// revokingSessionsStub records whose sessions were revoked
+type revokingSessionsStub struct {
+	Sessions
+	revoked []string
+}
+
+func (s *revokingSessionsStub) RevokeUserSessions(ctx context.Context, userID string) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+func TestAssignUserRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		callerRole string
+		targetRole string
+		newRole    string
+		wantErr    error
+	}{
+		{"admin promotes a user", values.AdminRole, values.UserRole, values.OrganizerRole, nil},
+		{"admin demotes an admin", values.AdminRole, values.AdminRole, values.UserRole, nil},
+		{"moderator grants a role beyond its own", moderatorRole.Name, values.UserRole, values.UserRole, types.ErrNotAuthorized},
+		{"moderator demotes an organizer", moderatorRole.Name, values.OrganizerRole, moderatorRole.Name, types.ErrNotAuthorized},
+		{"moderator demotes an admin", moderatorRole.Name, values.AdminRole, moderatorRole.Name, types.ErrNotAuthorized},
+		{"moderator moves a user whose role was deleted", moderatorRole.Name, "deleted", moderatorRole.Name, nil},
+		{"organizer can't assign roles", values.OrganizerRole, values.UserRole, values.UserRole, types.ErrNotAuthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &roleUsersStubRepository{users: map[string]*entities.User{
+				"target": {ID: "target", Role: tt.targetRole},
+			}}
+			sessions := &revokingSessionsStub{}
+			policy := NewPolicyService(&rolesStubRepository{roles: map[string]*entities.Role{moderatorRole.Name: moderatorRole}})
+			roles := NewRolesService(nil, users, sessions, policy)
+
+			user, err := roles.AssignUserRole(context.Background(), &requests.AssignUserRoleRequest{
+				Body:   requests.AssignUserRoleRequestBody{Role: tt.newRole},
+				ID:     "target",
+				UserID: "caller",
+				Role:   tt.callerRole,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AssignUserRole error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if got := users.users["target"].Role; got != tt.targetRole {
+					t.Errorf("role changed to %q", got)
+				}
+				if len(sessions.revoked) > 0 {
+					t.Errorf("sessions revoked: %v", sessions.revoked)
+				}
+				return
+			}
+
+			if user.Role != tt.newRole || users.users["target"].Role != tt.newRole {
+				t.Errorf("role = %q, stored %q, want %q", user.Role, users.users["target"].Role, tt.newRole)
+			}
+			if len(sessions.revoked) != 1 || sessions.revoked[0] != "target" {
+				t.Errorf("revoked sessions = %v, want the target's", sessions.revoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// internal/application/service/seat_maps.service.go
+package service
+
+import (
+	"context"
+	"strconv"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type SeatMaps interface {
+	CreateSeatMap(ctx context.Context, input *requests.CreateSeatMapRequest) (*entities.SeatMap, error)
+	GetSeatMapByID(ctx context.Context, input *requests.GetSeatMapByIDRequest) (*entities.SeatMap, error)
+	GetOrganizerSeatMaps(ctx context.Context, input *requests.GetOrganizerSeatMapsRequest) ([]*entities.SeatMap, error)
+	AttachSeatMap(ctx context.Context, input *requests.AttachSeatMapRequest) error
+	GetEventSeats(ctx context.Context, input *requests.GetEventSeatsRequest) (*entities.SeatMap, error)
+}
+
+type seatMapsService struct {
+	repo       repository.SeatMapsRepository
+	eventsRepo repository.EventsRepository
+	venuesRepo repository.VenuesRepository
+	commonRepo repository.CommonRepository
+	policy     Policy
+}
+
+func NewSeatMapsService(repo repository.SeatMapsRepository, eventsRepo repository.EventsRepository, venuesRepo repository.VenuesRepository, commonRepo repository.CommonRepository, policy Policy) *seatMapsService {
+	return &seatMapsService{
+		repo:       repo,
+		eventsRepo: eventsRepo,
+		venuesRepo: venuesRepo,
+		commonRepo: commonRepo,
+		policy:     policy,
+	}
+}
+
+func (s *seatMapsService) CreateSeatMap(ctx context.Context, input *requests.CreateSeatMapRequest) (*entities.SeatMap, error) {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceSeatMaps, values.ActionCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seat maps of a venue are drawn by whoever manages it
+	if input.Body.VenueID != "" {
+		if _, err := s.venuesRepo.GetVenueByID(ctx, input.Body.VenueID); err != nil {
+			return nil, err
+		}
+		if scope == values.ScopeOwn {
+			if err := s.venuesRepo.ValidateVenueOwnership(ctx, input.Body.VenueID, input.OrganizerID); err != nil {
+				return nil, types.ErrNotAuthorized
+			}
+		}
+	}
+
+	seatMap := &entities.SeatMap{
+		OrganizerID: input.OrganizerID,
+		VenueID:     input.Body.VenueID,
+		Name:        input.Body.Name,
+	}
+
+	for _, section := range input.Body.Sections {
+		seatSection := &entities.SeatSection{Name: section.Name}
+		for _, row := range section.Rows {
+			seatRow := &entities.SeatRow{Label: row.Label}
+			for number := 1; number <= row.Seats; number++ {
+				seatRow.Seats = append(seatRow.Seats, &entities.Seat{Number: strconv.Itoa(number)})
+			}
+			seatSection.Rows = append(seatSection.Rows, seatRow)
+		}
+		seatMap.Sections = append(seatMap.Sections, seatSection)
+	}
+
+	if err := s.repo.CreateSeatMap(ctx, seatMap); err != nil {
+		return nil, err
+	}
+
+	return seatMap, nil
+}
+
+func (s *seatMapsService) GetSeatMapByID(ctx context.Context, input *requests.GetSeatMapByIDRequest) (*entities.SeatMap, error) {
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceSeatMaps, values.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	seatMap, err := s.repo.GetSeatMapByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Organizers can only view their own seat maps
+	if scope == values.ScopeOwn && seatMap.OrganizerID != input.OrganizerID {
+		return nil, types.ErrNotAuthorized
+	}
+
+	return seatMap, nil
+}
+
+func (s *seatMapsService) GetOrganizerSeatMaps(ctx context.Context, input *requests.GetOrganizerSeatMapsRequest) ([]*entities.SeatMap, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceSeatMaps, values.ActionRead, values.ScopeOwn); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetSeatMapsByOrganizer(ctx, input.OrganizerID)
+}
+
+func (s *seatMapsService) AttachSeatMap(ctx context.Context, input *requests.AttachSeatMapRequest) error {
+	// Attaching a seat map changes the event
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceEvents, values.ActionUpdate)
+	if err != nil {
+		return err
+	}
+
+	// For organizer, verify they may manage the event and own the seat map
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckEventPermission(ctx, input.EventID, input.OrganizerID, values.OrganizationPermissionManageEvents); err != nil {
+			return types.ErrNotAuthorized
+		}
+		if err := s.repo.ValidateSeatMapOwnership(ctx, input.Body.SeatMapID, input.OrganizerID); err != nil {
+			return types.ErrNotAuthorized
+		}
+	}
+
+	return s.repo.AttachSeatMapToEvent(ctx, input.EventID, input.Body.SeatMapID)
+}
+
+// GetEventSeats shows the seats of an event to whoever may see the event
+func (s *seatMapsService) GetEventSeats(ctx context.Context, input *requests.GetEventSeatsRequest) (*entities.SeatMap, error) {
+	event, err := s.eventsRepo.GetEventByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkEventVisible(ctx, s.policy, s.commonRepo, event, input.UserID, input.Role); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEventSeats(ctx, input.EventID)
+}
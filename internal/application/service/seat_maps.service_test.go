@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type seatsEventsStubRepository struct {
+	repository.EventsRepository
+	events map[string]*entities.Event
+}
+
+func (r *seatsEventsStubRepository) GetEventByID(ctx context.Context, eventID string) (*entities.Event, error) {
+	event, ok := r.events[eventID]
+	if !ok {
+		return nil, domainErrors.ErrEventNotFound
+	}
+	return event, nil
+}
+
+type seatsStubRepository struct {
+	repository.SeatMapsRepository
+}
+
+func (r *seatsStubRepository) GetEventSeats(ctx context.Context, eventID string) (*entities.SeatMap, error) {
+	return &entities.SeatMap{ID: "map-of-" + eventID}, nil
+}
+
+// eventMembersStubRepository lets each user see the event listed for them
+type eventMembersStubRepository struct {
+	repository.CommonRepository
+	visible map[string]string
+}
+
+func (r *eventMembersStubRepository) CheckEventPermission(ctx context.Context, eventID, userID, permission string) error {
+	if r.visible[userID] != eventID {
+		return domainErrors.ErrEventNotFound
+	}
+	return nil
+}
+
+func TestGetEventSeatsVisibility(t *testing.T) {
+	events := &seatsEventsStubRepository{events: map[string]*entities.Event{
+		"published": {ID: "published", Status: values.EventStatusPublished},
+		"draft":     {ID: "draft", Status: values.EventStatusDraft},
+	}}
+	common := &eventMembersStubRepository{visible: map[string]string{"member": "draft"}}
+	s := NewSeatMapsService(&seatsStubRepository{}, events, nil, common, NewPolicyService(nil))
+
+	tests := []struct {
+		name    string
+		eventID string
+		userID  string
+		role    string
+		wantErr error
+	}{
+		{"published event to a user", "published", "buyer", values.UserRole, nil},
+		{"draft event to a user", "draft", "buyer", values.UserRole, types.ErrNotAuthorized},
+		{"draft event to a member of its organization", "draft", "member", values.OrganizerRole, nil},
+		{"draft event to another organizer", "draft", "outsider", values.OrganizerRole, types.ErrNotAuthorized},
+		{"draft event to an admin", "draft", "admin", values.AdminRole, nil},
+		{"unknown event", "unknown", "buyer", values.UserRole, domainErrors.ErrEventNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seatMap, err := s.GetEventSeats(context.Background(), &requests.GetEventSeatsRequest{
+				EventID: tt.eventID,
+				UserID:  tt.userID,
+				Role:    tt.role,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetEventSeats error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && seatMap.ID != "map-of-"+tt.eventID {
+				t.Errorf("seat map = %+v, want the one of %s", seatMap, tt.eventID)
+			}
+		})
+	}
+}
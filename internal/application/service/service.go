@@ -4,20 +4,59 @@ import (
 	"ticket-booking-app-backend/internal/domain/repository"
 	"ticket-booking-app-backend/internal/helpers"
 	"ticket-booking-app-backend/internal/infrastructure/jobs"
+	"ticket-booking-app-backend/internal/infrastructure/mail"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
 )
 
 type Services struct {
+	Policy Policy // Evaluates permissions for the middleware, the services are handed it when built
+
 	Users
+	Sessions
+	Roles
+	Organizers
+	Organizations
 	Events
+	EventSeries
+	EventReviews
 	Tickets
-	EventUpdater *jobs.EventStatusUpdater
+	TicketTypes
+	SeatMaps
+	Venues
+	Categories
+	Payments
+	EventUpdater       *jobs.EventStatusUpdater
+	EventPublisher     *jobs.EventPublisher
+	ReservationExpirer *jobs.ReservationExpirer
+	SeriesMaterializer *jobs.SeriesMaterializer
+	RefundRetrier      *jobs.RefundRetrier
 }
 
-func NewServices(repos *repository.Repository, jwt helpers.Jwt) *Services {
+func NewServices(repos *repository.Repository, jwt helpers.Jwt, mailer mail.Mailer, paymentProvider payments.Provider, paymentWebhookSecret string, verificationCodeLength int, moderation bool) *Services {
+	policy := NewPolicyService(repos.Roles)
+	sessions := NewSessionsService(repos.Users, repos.RefreshTokens, policy)
+	paymentsService := NewPaymentsService(repos.Payments, repos.Tickets, repos.Refunds, repos.Events, repos.Common, paymentProvider, paymentWebhookSecret, policy)
+
 	return &Services{
-		Users:        NewUsersService(repos.Users, repos.Common, jwt),
-		Events:       NewEventsService(repos.Events, repos.Common),
-		Tickets:      NewTicketsService(repos.Tickets, repos.Common),
-		EventUpdater: jobs.NewEventStatusUpdater(repos.Events),
+		Policy:             policy,
+		Users:              NewUsersService(repos.Users, repos.Common, repos.Organizations, repos.RefreshTokens, repos.Verifications, repos.Resets, sessions, jwt, mailer, verificationCodeLength),
+		Sessions:           sessions,
+		Roles:              NewRolesService(repos.Roles, repos.Users, sessions, policy),
+		Organizers:         NewOrganizersService(repos.Users, sessions, policy),
+		Organizations:      NewOrganizationsService(repos.Organizations, repos.Users, repos.Common, mailer, policy),
+		Events:             NewEventsService(repos.Events, repos.Search, repos.Common, repos.Tickets, repos.Venues, repos.Reviews, repos.Organizations, paymentsService, policy, moderation),
+		EventSeries:        NewEventSeriesService(repos.EventSeries, repos.Common, repos.Venues, repos.Organizations, policy, moderation),
+		EventReviews:       NewEventReviewsService(repos.Reviews, repos.Events, repos.Common, policy),
+		Tickets:            NewTicketsService(repos.Tickets, repos.Common, policy),
+		TicketTypes:        NewTicketTypesService(repos.TicketTypes, repos.Common, policy),
+		SeatMaps:           NewSeatMapsService(repos.SeatMaps, repos.Events, repos.Venues, repos.Common, policy),
+		Venues:             NewVenuesService(repos.Venues, policy),
+		Categories:         NewCategoriesService(repos.Categories, policy),
+		Payments:           paymentsService,
+		EventUpdater:       jobs.NewEventStatusUpdater(repos.Events),
+		EventPublisher:     jobs.NewEventPublisher(repos.Events),
+		ReservationExpirer: jobs.NewReservationExpirer(repos.Tickets),
+		SeriesMaterializer: jobs.NewSeriesMaterializer(repos.EventSeries),
+		RefundRetrier:      jobs.NewRefundRetrier(paymentsService),
 	}
 }
@@ -0,0 +1,213 @@
+// internal/application/service/sessions.service.go
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// Sessions ends sessions before their tokens expire, the auth middleware asks it whether
+// an access token is still good
+type Sessions interface {
+	// CheckSession fails with ErrSessionRevoked when the access token's session was signed out
+	CheckSession(ctx context.Context, userID, sessionID string, tokenVersion int) error
+	Logout(ctx context.Context, input *requests.LogoutRequest) error
+	LogoutAll(ctx context.Context, input *requests.LogoutAllRequest) error
+	ForceLogout(ctx context.Context, input *requests.ForceLogoutRequest) error
+	// RevokeUserSessions signs the user out everywhere, for when their account changes
+	RevokeUserSessions(ctx context.Context, userID string) error
+	// RevokeOtherSessions signs the user out everywhere but the session, for changes the user made themselves
+	RevokeOtherSessions(ctx context.Context, userID, sessionID string) error
+	// Forget drops the user's cached sessions once some of them were revoked elsewhere. The cache
+	// is held by each instance of the app and Forget only reaches this one, the others keep
+	// accepting the revoked sessions for up to values.SessionCacheTTLSeconds.
+	Forget(userID string)
+}
+
+// sessionState is what's needed to tell a revoked access token of the user apart
+type sessionState struct {
+	tokenVersion    int
+	revokedSessions map[string]struct{}
+	expiresAt       time.Time
+}
+
+type sessionsService struct {
+	usersRepo         repository.UsersRepository
+	refreshTokensRepo repository.RefreshTokensRepository
+	policy            Policy
+
+	mu          sync.RWMutex
+	states      map[string]sessionState // Users seen lately, so a request doesn't cost a query
+	generations map[string]uint64       // Bumped by Forget, a state read before the bump isn't cached
+	loading     int                     // State reads waiting on the database
+	prunedAt    time.Time               // Last time the expired states were dropped
+}
+
+func NewSessionsService(usersRepo repository.UsersRepository, refreshTokensRepo repository.RefreshTokensRepository, policy Policy) *sessionsService {
+	return &sessionsService{
+		usersRepo:         usersRepo,
+		refreshTokensRepo: refreshTokensRepo,
+		policy:            policy,
+		states:            make(map[string]sessionState),
+		generations:       make(map[string]uint64),
+	}
+}
+
+func (s *sessionsService) CheckSession(ctx context.Context, userID, sessionID string, tokenVersion int) error {
+	state, err := s.state(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if tokenVersion != state.tokenVersion {
+		return domainErrors.ErrSessionRevoked
+	}
+	if _, ok := state.revokedSessions[sessionID]; ok {
+		return domainErrors.ErrSessionRevoked
+	}
+	return nil
+}
+
+// Logout ends the session the access token belongs to, its refresh token stops working too
+func (s *sessionsService) Logout(ctx context.Context, input *requests.LogoutRequest) error {
+	// Tokens issued before sessions were tracked can only be signed out everywhere
+	if input.SessionID == "" {
+		return s.RevokeUserSessions(ctx, input.UserID)
+	}
+
+	if err := s.refreshTokensRepo.RevokeRefreshTokenFamily(ctx, input.UserID, input.SessionID); err != nil {
+		return err
+	}
+
+	s.Forget(input.UserID)
+	return nil
+}
+
+func (s *sessionsService) LogoutAll(ctx context.Context, input *requests.LogoutAllRequest) error {
+	return s.RevokeUserSessions(ctx, input.UserID)
+}
+
+func (s *sessionsService) ForceLogout(ctx context.Context, input *requests.ForceLogoutRequest) error {
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceUsers, values.ActionUpdate, values.ScopeAny); err != nil {
+		return err
+	}
+
+	return s.RevokeUserSessions(ctx, input.ID)
+}
+
+func (s *sessionsService) RevokeUserSessions(ctx context.Context, userID string) error {
+	if err := s.usersRepo.RevokeSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	s.Forget(userID)
+	return nil
+}
+
+func (s *sessionsService) RevokeOtherSessions(ctx context.Context, userID, sessionID string) error {
+	// Tokens issued before sessions were tracked can't be told apart, they all end
+	if sessionID == "" {
+		return s.RevokeUserSessions(ctx, userID)
+	}
+
+	if err := s.refreshTokensRepo.RevokeOtherRefreshTokenFamilies(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	s.Forget(userID)
+	return nil
+}
+
+// state returns the user's token version and revoked sessions, read from the database
+// at most once per cache period
+func (s *sessionsService) state(ctx context.Context, userID string) (sessionState, error) {
+	s.mu.RLock()
+	cached, ok := s.states[userID]
+	s.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached, nil
+	}
+
+	s.mu.Lock()
+	generation := s.generations[userID]
+	s.loading++
+	s.mu.Unlock()
+
+	state, err := s.readState(ctx, userID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loading--
+	if err != nil {
+		return sessionState{}, err
+	}
+
+	// Sessions revoked while the state was read may be missing from it, it isn't kept then
+	// and the next request reads it again
+	if s.generations[userID] == generation {
+		s.pruneExpired(time.Now())
+		s.states[userID] = state
+	}
+	return state, nil
+}
+
+// readState reads the user's token version and revoked sessions from the database
+func (s *sessionsService) readState(ctx context.Context, userID string) (sessionState, error) {
+	user, err := s.usersRepo.GetByID(ctx, userID)
+	if err != nil {
+		// Removed users have no session left
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			return sessionState{}, domainErrors.ErrSessionRevoked
+		}
+		return sessionState{}, err
+	}
+
+	families, err := s.refreshTokensRepo.GetRevokedFamilies(ctx, userID)
+	if err != nil {
+		return sessionState{}, err
+	}
+
+	state := sessionState{
+		tokenVersion:    user.TokenVersion,
+		revokedSessions: make(map[string]struct{}, len(families)),
+		expiresAt:       time.Now().Add(values.SessionCacheTTLSeconds * time.Second),
+	}
+	for _, family := range families {
+		state.revokedSessions[family] = struct{}{}
+	}
+	return state, nil
+}
+
+// pruneExpired drops the states of users who weren't seen for a cache period, so users
+// signing in once don't stay in memory. It sweeps at most once per period, s.mu must be held.
+func (s *sessionsService) pruneExpired(now time.Time) {
+	if now.Sub(s.prunedAt) < values.SessionCacheTTLSeconds*time.Second {
+		return
+	}
+	s.prunedAt = now
+
+	for userID, state := range s.states {
+		if !now.Before(state.expiresAt) {
+			delete(s.states, userID)
+		}
+	}
+
+	// Generations only matter to the reads under way
+	if s.loading == 0 {
+		s.generations = make(map[string]uint64)
+	}
+}
+
+func (s *sessionsService) Forget(userID string) {
+	s.mu.Lock()
+	delete(s.states, userID)
+	s.generations[userID]++
+	s.mu.Unlock()
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type sessionsUsersStubRepository struct {
+	repository.UsersRepository
+}
+
+func (r *sessionsUsersStubRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	return &entities.User{ID: userID}, nil
+}
+
+type sessionsRefreshTokensStubRepository struct {
+	repository.RefreshTokensRepository
+}
+
+func (r *sessionsRefreshTokensStubRepository) GetRevokedFamilies(ctx context.Context, userID string) ([]string, error) {
+	return nil, nil
+}
+
+func TestSessionsServicePrunesExpiredStates(t *testing.T) {
+	s := NewSessionsService(&sessionsUsersStubRepository{}, &sessionsRefreshTokensStubRepository{}, nil)
+	ctx := context.Background()
+
+	// Users seen more than a cache period ago
+	expired := time.Now().Add(-time.Second)
+	for i := 0; i < 100; i++ {
+		s.states[fmt.Sprintf("gone-%d", i)] = sessionState{expiresAt: expired}
+	}
+	s.states["recent"] = sessionState{expiresAt: time.Now().Add(values.SessionCacheTTLSeconds * time.Second)}
+
+	if err := s.CheckSession(ctx, "new", "", 0); err != nil {
+		t.Fatalf("CheckSession: %s", err)
+	}
+
+	if len(s.states) != 2 {
+		t.Errorf("%d states cached, want the recent and the new user's", len(s.states))
+	}
+	for _, userID := range []string{"recent", "new"} {
+		if _, ok := s.states[userID]; !ok {
+			t.Errorf("state of %s was dropped", userID)
+		}
+	}
+
+	// The next sweep waits for the cache period to pass
+	s.states["gone-again"] = sessionState{expiresAt: expired}
+	if err := s.CheckSession(ctx, "another", "", 0); err != nil {
+		t.Fatalf("CheckSession: %s", err)
+	}
+	if _, ok := s.states["gone-again"]; !ok {
+		t.Error("states were swept twice within a cache period")
+	}
+}
+
+// slowUsersStubRepository holds the first read of a user until it's told to go on, so the
+// sessions can be revoked meanwhile
+type slowUsersStubRepository struct {
+	repository.UsersRepository
+	mu           sync.Mutex
+	tokenVersion int
+	reading      chan struct{}
+	proceed      chan struct{}
+}
+
+func (r *slowUsersStubRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	r.mu.Lock()
+	tokenVersion := r.tokenVersion
+	r.mu.Unlock()
+
+	if r.reading != nil {
+		close(r.reading)
+		r.reading = nil
+		<-r.proceed
+	}
+	return &entities.User{ID: userID, TokenVersion: tokenVersion}, nil
+}
+
+func TestSessionsServiceDoesNotCacheStateReadBeforeForget(t *testing.T) {
+	reading, proceed := make(chan struct{}), make(chan struct{})
+	users := &slowUsersStubRepository{reading: reading, proceed: proceed}
+	s := NewSessionsService(users, &sessionsRefreshTokensStubRepository{}, nil)
+	ctx := context.Background()
+
+	done := make(chan error)
+	go func() {
+		done <- s.CheckSession(ctx, "user", "", 0)
+	}()
+
+	// The sessions are revoked while the old token version is being read
+	<-reading
+	users.mu.Lock()
+	users.tokenVersion++
+	users.mu.Unlock()
+	s.Forget("user")
+	close(proceed)
+
+	if err := <-done; err != nil {
+		t.Fatalf("CheckSession racing the revocation: %s", err)
+	}
+	if _, ok := s.states["user"]; ok {
+		t.Error("the state read before Forget was cached")
+	}
+
+	if err := s.CheckSession(ctx, "user", "", 0); !errors.Is(err, domainErrors.ErrSessionRevoked) {
+		t.Errorf("CheckSession with the revoked token version error = %v, want %v", err, domainErrors.ErrSessionRevoked)
+	}
+}
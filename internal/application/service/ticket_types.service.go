@@ -0,0 +1,134 @@
+// internal/application/service/ticket_types.service.go
+package service
+
+import (
+	"context"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type TicketTypes interface {
+	CreateTicketType(ctx context.Context, input *requests.CreateTicketTypeRequest) (*entities.TicketType, error)
+	GetEventTicketTypes(ctx context.Context, input *requests.GetEventTicketTypesRequest) ([]*entities.TicketType, error)
+	UpdateTicketType(ctx context.Context, input *requests.UpdateTicketTypeRequest) (*entities.TicketType, error)
+	DeleteTicketType(ctx context.Context, input *requests.DeleteTicketTypeRequest) error
+}
+
+type ticketTypesService struct {
+	repo       repository.TicketTypesRepository
+	commonRepo repository.CommonRepository
+	policy     Policy
+}
+
+func NewTicketTypesService(repo repository.TicketTypesRepository, commonRepo repository.CommonRepository, policy Policy) *ticketTypesService {
+	return &ticketTypesService{
+		repo:       repo,
+		commonRepo: commonRepo,
+		policy:     policy,
+	}
+}
+
+func (s *ticketTypesService) CreateTicketType(ctx context.Context, input *requests.CreateTicketTypeRequest) (*entities.TicketType, error) {
+	if err := s.checkEventAccess(ctx, input.EventID, input.OrganizerID, input.Role, values.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	ticketType := toTicketType(&input.Body)
+	ticketType.EventID = input.EventID
+
+	if err := validateSalesWindow(ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateTicketType(ctx, ticketType); err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+func (s *ticketTypesService) GetEventTicketTypes(ctx context.Context, input *requests.GetEventTicketTypesRequest) ([]*entities.TicketType, error) {
+	if err := s.commonRepo.CheckIfEventExists(ctx, input.EventID); err != nil {
+		return nil, domainErrors.ErrEventNotFound
+	}
+
+	return s.repo.GetTicketTypesByEvent(ctx, input.EventID)
+}
+
+func (s *ticketTypesService) UpdateTicketType(ctx context.Context, input *requests.UpdateTicketTypeRequest) (*entities.TicketType, error) {
+	if err := s.checkEventAccess(ctx, input.EventID, input.OrganizerID, input.Role, values.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	ticketType := toTicketType(&input.Body)
+	ticketType.ID = input.ID
+	ticketType.EventID = input.EventID
+
+	if err := validateSalesWindow(ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateTicketType(ctx, ticketType); err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+func (s *ticketTypesService) DeleteTicketType(ctx context.Context, input *requests.DeleteTicketTypeRequest) error {
+	if err := s.checkEventAccess(ctx, input.EventID, input.OrganizerID, input.Role, values.ActionDelete); err != nil {
+		return err
+	}
+
+	ticketType, err := s.repo.GetTicketTypeByID(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if ticketType.EventID != input.EventID {
+		return domainErrors.ErrTicketTypeNotFound
+	}
+
+	return s.repo.DeleteTicketType(ctx, input.ID)
+}
+
+// checkEventAccess allows roles with the action on any ticket type, and the members managing the event.
+func (s *ticketTypesService) checkEventAccess(ctx context.Context, eventID, organizerID, role, action string) error {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, role, values.ResourceTicketTypes, action)
+	if err != nil {
+		return err
+	}
+
+	// For organizer, verify they may manage the event
+	if scope == values.ScopeOwn {
+		if err := s.commonRepo.CheckEventPermission(ctx, eventID, organizerID, values.OrganizationPermissionManageEvents); err != nil {
+			return types.ErrNotAuthorized
+		}
+	}
+
+	return nil
+}
+
+func toTicketType(body *requests.TicketTypeRequestBody) *entities.TicketType {
+	return &entities.TicketType{
+		Name:         body.Name,
+		Price:        body.Price,
+		Capacity:     body.Capacity,
+		SalesStartAt: body.SalesStartAt,
+		SalesEndAt:   body.SalesEndAt,
+		MaxPerUser:   body.MaxPerUser,
+	}
+}
+
+func validateSalesWindow(ticketType *entities.TicketType) error {
+	if ticketType.SalesStartAt != nil && ticketType.SalesEndAt != nil &&
+		!ticketType.SalesEndAt.After(*ticketType.SalesStartAt) {
+		return domainErrors.ErrTicketTypeSalesWindow
+	}
+	return nil
+}
@@ -24,41 +24,37 @@ type Tickets interface {
 type ticketsService struct {
 	repo       repository.TicketsRepository
 	commonRepo repository.CommonRepository
+	policy     Policy
 }
 
-func NewTicketsService(repo repository.TicketsRepository, commonRepo repository.CommonRepository) *ticketsService {
+func NewTicketsService(repo repository.TicketsRepository, commonRepo repository.CommonRepository, policy Policy) *ticketsService {
 	return &ticketsService{
 		repo:       repo,
 		commonRepo: commonRepo,
+		policy:     policy,
 	}
 }
 
 func (s *ticketsService) ReserveTickets(ctx context.Context, input *requests.ReserveTicketsRequest) ([]*entities.Ticket, error) {
-	// Validate ticket quantity
-	if input.Body.Quantity > values.MaxTicketsPerPurchase {
-		return nil, fmt.Errorf("cannot reserve more than %d tickets at once", values.MaxTicketsPerPurchase)
-	}
-
-	if err := s.commonRepo.CheckIfUserExceededCapacityForEvent(ctx, input.EventID, input.UserID, input.Body.Quantity); err != nil {
+	// Tickets are only sold to accounts with a confirmed email
+	if err := s.commonRepo.CheckIfUserIsVerified(ctx, input.UserID); err != nil {
 		return nil, err
 	}
 
-	// Verify event is active
-	if err := s.commonRepo.CheckIfEventIsActive(ctx, input.EventID); err != nil {
-		return nil, err
-	}
+	selections := ticketSelections(&input.Body)
 
-	// Check if there's enough capacity
-	remainingCapacity, err := s.commonRepo.CheckEventAvailableCapacity(ctx, input.EventID)
-	if err != nil {
-		return nil, err
+	// Validate ticket quantity
+	quantity := 0
+	for _, selection := range selections {
+		quantity += selection.Quantity
 	}
-	if remainingCapacity < input.Body.Quantity {
-		return nil, domainErrors.ErrInsufficientTickets
+	if quantity > values.MaxTicketsPerPurchase {
+		return nil, fmt.Errorf("cannot reserve more than %d tickets at once", values.MaxTicketsPerPurchase)
 	}
 
-	// Create tickets now that we've verified everything
-	tickets, err := s.repo.CreateTickets(ctx, input.EventID, input.UserID, input.Body.Quantity)
+	// Event status, the per-user limit and capacity are checked while
+	// the event is locked, so parallel reservations can't oversell it
+	tickets, err := s.repo.CreateTickets(ctx, input.EventID, input.UserID, selections)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +69,11 @@ func (s *ticketsService) GetTicketByID(ctx context.Context, input *requests.GetT
 	}
 
 	// Check permissions
-	if input.Role == values.UserRole {
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceTickets, values.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+	if scope == values.ScopeOwn {
 		if err := s.repo.ValidateTicketOwnership(ctx, input.TicketID, input.UserID); err != nil {
 			return nil, types.ErrNotAuthorized
 		}
@@ -88,15 +88,16 @@ func (s *ticketsService) GetUserTickets(ctx context.Context, input *requests.Get
 
 func (s *ticketsService) GetEventTickets(ctx context.Context, input *requests.GetEventTicketsRequest) ([]*entities.Ticket, error) {
 	// Verify permissions
-	if input.Role != values.AdminRole && input.Role != values.OrganizerRole {
-		return nil, types.ErrNotAuthorized
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceTickets, values.ActionRead)
+	if err != nil {
+		return nil, err
 	}
 
-	// For organizer, verify they own the event
-	if input.Role == values.OrganizerRole {
-		err := s.commonRepo.CheckIfEventBelongsToOrganizer(ctx, input.EventID, input.OrganizerID)
+	// For organizer, verify they may see the event
+	if scope == values.ScopeOwn {
+		err := s.commonRepo.CheckEventPermission(ctx, input.EventID, input.OrganizerID, values.OrganizationPermissionViewEvents)
 		if err != nil {
-			return nil, err
+			return nil, types.ErrNotAuthorized
 		}
 	}
 
@@ -110,7 +111,11 @@ func (s *ticketsService) CancelTicket(ctx context.Context, input *requests.Cance
 	}
 
 	// Verify permissions
-	if input.Role == values.UserRole {
+	scope, err := s.policy.Scope(ctx, input.Role, values.ResourceTickets, values.ActionCancel)
+	if err != nil {
+		return err
+	}
+	if scope == values.ScopeOwn {
 		if err := s.repo.ValidateTicketOwnership(ctx, input.TicketID, input.UserID); err != nil {
 			return types.ErrNotAuthorized
 		}
@@ -121,5 +126,41 @@ func (s *ticketsService) CancelTicket(ctx context.Context, input *requests.Cance
 		return domainErrors.ErrInvalidTicketStatus
 	}
 
-	return s.repo.UpdateTicketStatus(ctx, input.TicketID, values.TicketStatusCancelled)
+	// Status is checked again under lock, the place goes back to the event
+	return s.repo.CancelTicket(ctx, input.TicketID)
+}
+
+// ticketSelections turns the request into one selection per ticket type, the
+// plain quantity and seats being tickets at the event's base price. When seats
+// are picked there is one ticket per seat.
+func ticketSelections(body *requests.ReserveTicketsRequestBody) []entities.TicketSelection {
+	var selections []entities.TicketSelection
+	indexes := make(map[string]int)
+
+	add := func(ticketTypeID string, quantity int, seatIDs []string) {
+		if len(seatIDs) > 0 {
+			quantity = len(seatIDs)
+		}
+		if quantity <= 0 {
+			return
+		}
+		if i, ok := indexes[ticketTypeID]; ok {
+			selections[i].Quantity += quantity
+			selections[i].SeatIDs = append(selections[i].SeatIDs, seatIDs...)
+			return
+		}
+		indexes[ticketTypeID] = len(selections)
+		selections = append(selections, entities.TicketSelection{
+			TicketTypeID: ticketTypeID,
+			Quantity:     quantity,
+			SeatIDs:      seatIDs,
+		})
+	}
+
+	add("", body.Quantity, body.SeatIDs)
+	for _, item := range body.Items {
+		add(item.TicketTypeID, item.Quantity, item.SeatIDs)
+	}
+
+	return selections
 }
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"ticket-booking-app-backend/internal/application/types/requests"
 	"ticket-booking-app-backend/internal/application/types/responses"
@@ -9,6 +12,7 @@ import (
 	"ticket-booking-app-backend/internal/domain/repository"
 	domainErrors "ticket-booking-app-backend/internal/domain/types"
 	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/internal/infrastructure/mail"
 	"ticket-booking-app-backend/pkg/values"
 
 	"github.com/sirupsen/logrus"
@@ -25,19 +29,41 @@ type Users interface {
 	AdminSignUp(ctx context.Context, input *requests.AdminSignUpRequest) error
 	OrganizerSignIn(ctx context.Context, input *requests.OrganizerSignInRequest) (*responses.TokenResponse, error)
 	OrganizerSignUp(ctx context.Context, input *requests.OrganizerSignUpRequest) error
+	RefreshToken(ctx context.Context, input *requests.RefreshTokenRequest) (*responses.TokenResponse, error)
+	VerifyEmail(ctx context.Context, input *requests.VerifyEmailRequest) error
+	ResendVerification(ctx context.Context, input *requests.ResendVerificationRequest) error
+	ForgotPassword(ctx context.Context, input *requests.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, input *requests.ResetPasswordRequest) error
+	GetProfile(ctx context.Context, input *requests.GetProfileRequest) (*entities.User, error)
+	UpdateProfile(ctx context.Context, input *requests.UpdateProfileRequest) (*entities.User, error)
+	ChangePassword(ctx context.Context, input *requests.ChangePasswordRequest) error
 }
 
 type usersService struct {
-	repo       repository.UsersRepository
-	commonRepo repository.CommonRepository
-	jwt        helpers.Jwt
+	repo              repository.UsersRepository
+	commonRepo        repository.CommonRepository
+	organizationsRepo repository.OrganizationsRepository
+	refreshTokensRepo repository.RefreshTokensRepository
+	verificationsRepo repository.EmailVerificationsRepository
+	resetsRepo        repository.PasswordResetsRepository
+	sessions          Sessions
+	jwt               helpers.Jwt
+	mailer            mail.Mailer
+	codeLength        int // Digits in an email verification code
 }
 
-func NewUsersService(repo repository.UsersRepository, commonRepo repository.CommonRepository, jwt helpers.Jwt) *usersService {
+func NewUsersService(repo repository.UsersRepository, commonRepo repository.CommonRepository, organizationsRepo repository.OrganizationsRepository, refreshTokensRepo repository.RefreshTokensRepository, verificationsRepo repository.EmailVerificationsRepository, resetsRepo repository.PasswordResetsRepository, sessions Sessions, jwt helpers.Jwt, mailer mail.Mailer, codeLength int) *usersService {
 	return &usersService{
-		repo:       repo,
-		commonRepo: commonRepo,
-		jwt:        jwt,
+		repo:              repo,
+		commonRepo:        commonRepo,
+		organizationsRepo: organizationsRepo,
+		refreshTokensRepo: refreshTokensRepo,
+		verificationsRepo: verificationsRepo,
+		resetsRepo:        resetsRepo,
+		sessions:          sessions,
+		jwt:               jwt,
+		mailer:            mailer,
+		codeLength:        codeLength,
 	}
 }
 
@@ -67,6 +93,11 @@ func (s *usersService) UserSignUp(ctx context.Context, input *requests.UserSignU
 		return err
 	}
 
+	// The account stays unverified until the code sent to the email is confirmed
+	if err := s.sendVerificationCode(ctx, &user); err != nil {
+		return err
+	}
+
 	return nil
 }
 func (s *usersService) UserSignIn(ctx context.Context, input *requests.UserSignInRequest) (*responses.TokenResponse, error) {
@@ -83,27 +114,14 @@ func (s *usersService) UserSignIn(ctx context.Context, input *requests.UserSignI
 		return nil, domainErrors.ErrUserPasswordIncorrect
 	}
 
-	token, err := s.jwt.CreateAccessToken(helpers.UserAccessTokenClaims{
-		UserId: user.ID,
-		Role:   values.UserRole,
-	})
-	if err != nil {
-		logrus.Errorf("Error creating access token: %s", err)
-		return nil, err
-	}
-	return &responses.TokenResponse{
-		Success:               true,
-		Token:                 token.AccessToken,
-		TokenType:             BEARER_TOKEN_TYPE,
-		ExpiresAt:             token.AccessTokenExpiresAt,
-	}, nil
+	return s.createTokens(ctx, user, "")
 }
 
 // AdminSignIn handles the sign-in process for admin users.
 func (s *usersService) AdminSignIn(ctx context.Context, input *requests.AdminSignInRequest) (*responses.TokenResponse, error) {
 	// Check if admin user exists
 	admin, err := s.repo.GetByEmail(ctx, input.Email)
-	if err != nil {
+	if err != nil || admin.Role != values.AdminRole {
 		return nil, domainErrors.ErrAdminNotFound
 	}
 
@@ -112,22 +130,7 @@ func (s *usersService) AdminSignIn(ctx context.Context, input *requests.AdminSig
 		return nil, domainErrors.ErrUserPasswordIncorrect
 	}
 
-	// Generate access token
-	token, err := s.jwt.CreateAccessToken(helpers.UserAccessTokenClaims{
-		UserId: admin.ID,
-		Role:   values.AdminRole,
-	})
-	if err != nil {
-		logrus.Errorf("Error creating access token: %s", err)
-		return nil, err
-	}
-
-	return &responses.TokenResponse{
-		Success:               true,
-		Token:                 token.AccessToken,
-		TokenType:             BEARER_TOKEN_TYPE,
-		ExpiresAt:             token.AccessTokenExpiresAt,
-	}, nil
+	return s.createTokens(ctx, admin, "")
 }
 
 // AdminSignUp handles the sign-up process for admin users.
@@ -145,10 +148,12 @@ func (s *usersService) AdminSignUp(ctx context.Context, input *requests.AdminSig
 		return err
 	}
 
-	// Create the admin user
+	// Create the admin user, their email is trusted as given
+	verifiedAt := time.Now()
 	admin := entities.User{
-		Email:    input.Email,
-		Password: hashedPassword,
+		Email:           input.Email,
+		Password:        hashedPassword,
+		EmailVerifiedAt: &verifiedAt,
 	}
 
 	// Save the admin to the repository
@@ -165,7 +170,7 @@ func (s *usersService) AdminSignUp(ctx context.Context, input *requests.AdminSig
 func (s *usersService) OrganizerSignIn(ctx context.Context, input *requests.OrganizerSignInRequest) (*responses.TokenResponse, error) {
 	// Check if organizer exists
 	organizer, err := s.repo.GetByEmail(ctx, input.Email)
-	if err != nil {
+	if err != nil || organizer.Role != values.OrganizerRole {
 		return nil, domainErrors.ErrOrganizerNotFound
 	}
 
@@ -174,22 +179,27 @@ func (s *usersService) OrganizerSignIn(ctx context.Context, input *requests.Orga
 		return nil, domainErrors.ErrUserPasswordIncorrect
 	}
 
-	// Generate access token
-	token, err := s.jwt.CreateAccessToken(helpers.UserAccessTokenClaims{
-		UserId: organizer.ID,
-		Role:   values.OrganizerRole,
-	})
-	if err != nil {
-		logrus.Errorf("Error creating access token: %s", err)
-		return nil, err
+	// Only approved organizers get tokens. The status, and the reason an admin gave for it,
+	// is only told once the password matched.
+	switch organizer.Status {
+	case values.UserStatusActive:
+	case values.UserStatusRejected:
+		return nil, withStatusReason(domainErrors.ErrOrganizerRejected, organizer.StatusReason)
+	case values.UserStatusSuspended:
+		return nil, withStatusReason(domainErrors.ErrOrganizerSuspended, organizer.StatusReason)
+	default:
+		return nil, domainErrors.ErrOrganizerNotApproved
 	}
 
-	return &responses.TokenResponse{
-		Success:               true,
-		Token:                 token.AccessToken,
-		TokenType:             BEARER_TOKEN_TYPE,
-		ExpiresAt:             token.AccessTokenExpiresAt,
-	}, nil
+	return s.createTokens(ctx, organizer, "")
+}
+
+// withStatusReason adds the reason an admin gave for an organizer's status to its error
+func withStatusReason(err error, reason string) error {
+	if reason == "" {
+		return err
+	}
+	return fmt.Errorf("%w: %s", err, reason)
 }
 
 // OrganizerSignUp handles the sign-up process for organizer users.
@@ -213,6 +223,7 @@ func (s *usersService) OrganizerSignUp(ctx context.Context, input *requests.Orga
 		Name:     input.Name,
 		Address:  input.Address,
 		Phone:    input.Phone,
+		Status:   values.UserStatusPending, // Can't sell until an admin approves the account
 	}
 
 	// Save the organizer to the repository
@@ -222,5 +233,296 @@ func (s *usersService) OrganizerSignUp(ctx context.Context, input *requests.Orga
 		return err
 	}
 
+	if err := s.sendVerificationCode(ctx, &organizer); err != nil {
+		return err
+	}
+
+	// Every organizer starts with an organization of their own, their events go there by default
+	organization := entities.Organization{
+		Name:    organizer.Name,
+		OwnerID: organizer.ID,
+	}
+	if organization.Name == "" {
+		organization.Name = organizer.Email
+	}
+	if err := s.organizationsRepo.CreateOrganization(ctx, &organization); err != nil {
+		logrus.Errorf("Error creating organization: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// RefreshToken swaps a refresh token for a new pair of tokens. The access token carries the
+// role the account holds now, so role changes apply from the next refresh.
+func (s *usersService) RefreshToken(ctx context.Context, input *requests.RefreshTokenRequest) (*responses.TokenResponse, error) {
+	claims, err := s.jwt.VerifyRefreshToken(input.RefreshToken)
+	if err != nil {
+		return nil, domainErrors.ErrRefreshTokenInvalid
+	}
+
+	user, err := s.repo.GetByID(ctx, claims.UserId)
+	if errors.Is(err, domainErrors.ErrUserNotFound) {
+		return nil, domainErrors.ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createTokens(ctx, user, helpers.HashToken(input.RefreshToken))
+}
+
+// VerifyEmail confirms the email address of the account with the code sent to it
+func (s *usersService) VerifyEmail(ctx context.Context, input *requests.VerifyEmailRequest) error {
+	user, err := s.repo.GetByEmail(ctx, input.Email)
+	if errors.Is(err, domainErrors.ErrUserNotFound) {
+		return domainErrors.ErrVerificationCodeInvalid
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.verificationsRepo.VerifyEmail(ctx, user.ID, verificationCodeHash(user.ID, input.Code))
+}
+
+// ResendVerification sends a new code, the previous one stops working. Unknown and already
+// verified emails, and requests within the cooldown, are silently ignored so the answer
+// doesn't tell whether the account exists.
+func (s *usersService) ResendVerification(ctx context.Context, input *requests.ResendVerificationRequest) error {
+	user, err := s.repo.GetByEmail(ctx, input.Email)
+	if errors.Is(err, domainErrors.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	// An unverified account without a code, e.g. when saving it failed at sign up, gets one now
+	verification, err := s.verificationsRepo.GetVerification(ctx, user.ID)
+	if errors.Is(err, domainErrors.ErrEmailAlreadyVerified) {
+		return s.sendVerificationCode(ctx, user)
+	}
+	if err != nil {
+		return err
+	}
+
+	if time.Since(verification.SentAt) < values.VerificationResendCooldownSeconds*time.Second {
+		logrus.Infof("Verification code for user %s was sent moments ago, not resending", user.ID)
+		return nil
+	}
+
+	return s.sendVerificationCode(ctx, user)
+}
+
+// ForgotPassword mails a password reset token to the account of the email. Whether an
+// account exists isn't revealed, unknown emails succeed without a mail.
+func (s *usersService) ForgotPassword(ctx context.Context, input *requests.ForgotPasswordRequest) error {
+	user, err := s.repo.GetByEmail(ctx, input.Email)
+	if errors.Is(err, domainErrors.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := helpers.NewToken()
+	if err != nil {
+		logrus.Errorf("Error generating password reset token: %s", err)
+		return err
+	}
+
+	reset := &entities.PasswordReset{
+		UserID:    user.ID,
+		Token:     token,
+		TokenHash: helpers.HashToken(token),
+		ExpiresAt: time.Now().Add(values.PasswordResetTTLMinutes * time.Minute),
+	}
+	if err := s.resetsRepo.CreatePasswordReset(ctx, reset); err != nil {
+		logrus.Errorf("Error saving password reset: %s", err)
+		return err
+	}
+
+	// Sent in the background, the answer has to match the one for unknown emails
+	s.sendMail(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Reset your password with the token %s\n\nIt works once and expires in %d minutes. If you didn't ask for a reset, ignore this email.",
+			reset.Token, values.PasswordResetTTLMinutes),
+	})
+	return nil
+}
+
+// ResetPassword sets a new password with a mailed reset token and signs the user out everywhere
+func (s *usersService) ResetPassword(ctx context.Context, input *requests.ResetPasswordRequest) error {
+	hashedPassword, err := helpers.HashPassword(input.Password)
+	if err != nil {
+		logrus.Errorf("Error hashing password: %s", err)
+		return err
+	}
+
+	userID, err := s.resetsRepo.ResetPassword(ctx, helpers.HashToken(input.Token), hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	// Whoever used the old password loses access
+	return s.sessions.RevokeUserSessions(ctx, userID)
+}
+
+func (s *usersService) GetProfile(ctx context.Context, input *requests.GetProfileRequest) (*entities.User, error) {
+	return s.repo.GetByID(ctx, input.UserID)
+}
+
+func (s *usersService) UpdateProfile(ctx context.Context, input *requests.UpdateProfileRequest) (*entities.User, error) {
+	user, err := s.repo.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Body.Name != nil {
+		user.Name = *input.Body.Name
+	}
+	if input.Body.Address != nil {
+		user.Address = *input.Body.Address
+	}
+	if input.Body.Phone != nil {
+		user.Phone = *input.Body.Phone
+	}
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		logrus.Errorf("Error updating profile: %s", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces the password of the user, who stays signed in only in the session
+// that changed it
+func (s *usersService) ChangePassword(ctx context.Context, input *requests.ChangePasswordRequest) error {
+	user, err := s.repo.GetByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !helpers.CheckPasswordHash(input.Body.CurrentPassword, user.Password) {
+		return domainErrors.ErrUserPasswordIncorrect
+	}
+
+	hashedPassword, err := helpers.HashPassword(input.Body.NewPassword)
+	if err != nil {
+		logrus.Errorf("Error hashing password: %s", err)
+		return err
+	}
+
+	if err := s.repo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		logrus.Errorf("Error updating password: %s", err)
+		return err
+	}
+
+	return s.sessions.RevokeOtherSessions(ctx, user.ID, input.SessionID)
+}
+
+// sendVerificationCode stores a new verification code for the user and mails it to them.
+// A code that couldn't be mailed stays stored, the user can ask for another one.
+func (s *usersService) sendVerificationCode(ctx context.Context, user *entities.User) error {
+	code, err := helpers.NewCode(s.codeLength)
+	if err != nil {
+		logrus.Errorf("Error generating verification code: %s", err)
+		return err
+	}
+
+	now := time.Now()
+	verification := &entities.EmailVerification{
+		UserID:    user.ID,
+		Code:      code,
+		CodeHash:  verificationCodeHash(user.ID, code),
+		ExpiresAt: now.Add(values.VerificationCodeTTLMinutes * time.Minute),
+		SentAt:    now,
+	}
+	if err := s.verificationsRepo.SaveVerification(ctx, verification); err != nil {
+		logrus.Errorf("Error saving verification code: %s", err)
+		return err
+	}
+
+	s.sendMail(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Your verification code is %s\n\nIt expires in %d minutes. If you didn't sign up, ignore this email.",
+			verification.Code, values.VerificationCodeTTLMinutes),
+	})
 	return nil
 }
+
+// sendMail sends the message in the background. Answering before the mail server does keeps
+// the response time from telling whether a mail was sent, failures are only logged.
+func (s *usersService) sendMail(ctx context.Context, message mail.Message) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.mailer.Send(ctx, message); err != nil {
+			logrus.Errorf("Error sending %q mail: %s", message.Subject, err)
+		}
+	}()
+}
+
+// verificationCodeHash ties the code to the user, codes are short and repeat across users
+func verificationCodeHash(userID, code string) string {
+	return helpers.HashToken(userID + ":" + code)
+}
+
+// createTokens issues an access token and a refresh token for the user. The refresh token
+// starts a new family, or follows the one with previousHash when it's being rotated.
+func (s *usersService) createTokens(ctx context.Context, user *entities.User, previousHash string) (*responses.TokenResponse, error) {
+	refreshToken, err := s.jwt.CreateRefreshToken(helpers.UserRefreshTokenClaims{
+		UserId: user.ID,
+	})
+	if err != nil {
+		logrus.Errorf("Error creating refresh token: %s", err)
+		return nil, err
+	}
+
+	// Only the hash is kept, a leaked table can't be used to sign in
+	stored := &entities.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: helpers.HashToken(refreshToken.RefreshToken),
+		ExpiresAt: time.Unix(refreshToken.RefreshTokenExpiresAt, 0),
+	}
+	if previousHash == "" {
+		err = s.refreshTokensRepo.CreateRefreshToken(ctx, stored)
+	} else {
+		err = s.refreshTokensRepo.RotateRefreshToken(ctx, previousHash, stored)
+	}
+	if errors.Is(err, domainErrors.ErrRefreshTokenReused) {
+		// The replayed session was revoked, its access tokens stop working right away
+		s.sessions.Forget(user.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The token carries the role the account holds, built-in or custom, and the session it
+	// belongs to so it can be signed out before it expires
+	accessToken, err := s.jwt.CreateAccessToken(helpers.UserAccessTokenClaims{
+		UserId:       user.ID,
+		Role:         user.Role,
+		SessionId:    stored.FamilyID,
+		TokenVersion: user.TokenVersion,
+	})
+	if err != nil {
+		logrus.Errorf("Error creating access token: %s", err)
+		return nil, err
+	}
+
+	return &responses.TokenResponse{
+		Success:               true,
+		Token:                 accessToken.AccessToken,
+		TokenType:             BEARER_TOKEN_TYPE,
+		ExpiresAt:             accessToken.AccessTokenExpiresAt,
+		RefreshToken:          refreshToken.RefreshToken,
+		RefreshTokenExpiresAt: refreshToken.RefreshTokenExpiresAt,
+	}, nil
+}
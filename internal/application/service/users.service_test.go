@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/internal/infrastructure/mail"
+)
+
+const testCodeLength = 6
+
+// Accounts of the users service tests, by email
+const (
+	unverifiedEmail = "unverified@example.com"
+	codelessEmail   = "codeless@example.com" // Unverified, saving its code failed at sign up
+	verifiedEmail   = "verified@example.com"
+	unknownEmail    = "unknown@example.com"
+)
+
+type usersStubRepository struct {
+	repository.UsersRepository
+	users map[string]*entities.User
+}
+
+func (r *usersStubRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
+	user, ok := r.users[email]
+	if !ok {
+		return nil, domainErrors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// verificationsStubRepository follows the semantics of the postgres repository, without
+// the attempts limit
+type verificationsStubRepository struct {
+	repository.EmailVerificationsRepository
+	mu            sync.Mutex
+	verifications map[string]entities.EmailVerification
+}
+
+func (r *verificationsStubRepository) SaveVerification(ctx context.Context, verification *entities.EmailVerification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.verifications[verification.UserID] = *verification
+	return nil
+}
+
+func (r *verificationsStubRepository) GetVerification(ctx context.Context, userID string) (*entities.EmailVerification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	verification, ok := r.verifications[userID]
+	if !ok {
+		return nil, domainErrors.ErrEmailAlreadyVerified
+	}
+	return &verification, nil
+}
+
+func (r *verificationsStubRepository) VerifyEmail(ctx context.Context, userID, codeHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	verification, ok := r.verifications[userID]
+	if !ok || verification.CodeHash != codeHash || !time.Now().Before(verification.ExpiresAt) {
+		return domainErrors.ErrVerificationCodeInvalid
+	}
+	delete(r.verifications, userID)
+	return nil
+}
+
+type resetsStubRepository struct {
+	repository.PasswordResetsRepository
+	mu     sync.Mutex
+	resets []entities.PasswordReset
+}
+
+func (r *resetsStubRepository) CreatePasswordReset(ctx context.Context, reset *entities.PasswordReset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resets = append(r.resets, *reset)
+	return nil
+}
+
+type usersServiceFixture struct {
+	service *usersService
+	mailer  *mail.MemoryMailer
+	resets  *resetsStubRepository
+}
+
+// newUsersServiceFixture knows two unverified accounts and a verified one, the first
+// unverified one was sent its code sentAgo
+func newUsersServiceFixture(sentAgo time.Duration) *usersServiceFixture {
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+	users := &usersStubRepository{users: map[string]*entities.User{
+		unverifiedEmail: {ID: "1", Email: unverifiedEmail},
+		verifiedEmail:   {ID: "2", Email: verifiedEmail, EmailVerifiedAt: &verifiedAt},
+		codelessEmail:   {ID: "3", Email: codelessEmail},
+	}}
+	sentAt := time.Now().Add(-sentAgo)
+	verifications := &verificationsStubRepository{verifications: map[string]entities.EmailVerification{
+		"1": {UserID: "1", CodeHash: verificationCodeHash("1", "123456"), SentAt: sentAt, ExpiresAt: sentAt.Add(time.Hour)},
+	}}
+	mailer := mail.NewMemoryMailer()
+	resets := &resetsStubRepository{}
+
+	return &usersServiceFixture{
+		service: NewUsersService(users, nil, nil, nil, verifications, resets, nil, nil, mailer, testCodeLength),
+		mailer:  mailer,
+		resets:  resets,
+	}
+}
+
+// waitForMail waits for the mail sent in the background to the address
+func waitForMail(t *testing.T, mailer *mail.MemoryMailer, to string) mail.Message {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if message, ok := mailer.Last(to); ok {
+			return message
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no mail was sent to %s", to)
+	return mail.Message{}
+}
+
+func TestResendVerificationDoesNotRevealAccounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		sentAgo  time.Duration
+		wantMail bool
+	}{
+		{"unverified account", unverifiedEmail, time.Hour, true},
+		{"unverified account within the cooldown", unverifiedEmail, time.Second, false},
+		{"unverified account without a code", codelessEmail, time.Second, true},
+		{"verified account", verifiedEmail, time.Hour, false},
+		{"unknown email", unknownEmail, time.Hour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newUsersServiceFixture(tt.sentAgo)
+
+			err := fixture.service.ResendVerification(context.Background(), &requests.ResendVerificationRequest{Email: tt.email})
+			if err != nil {
+				t.Fatalf("ResendVerification: %s", err)
+			}
+
+			if !tt.wantMail {
+				if messages := fixture.mailer.Messages(); len(messages) > 0 {
+					t.Errorf("mails were sent: %+v", messages)
+				}
+				return
+			}
+
+			// The new code replaces the previous one and is the one that verifies the account
+			message := waitForMail(t, fixture.mailer, tt.email)
+			code := strings.TrimPrefix(strings.SplitN(message.Body, "\n", 2)[0], "Your verification code is ")
+			if len(code) != testCodeLength {
+				t.Fatalf("no code in the mail %q", message.Body)
+			}
+			err = fixture.service.VerifyEmail(context.Background(), &requests.VerifyEmailRequest{Email: tt.email, Code: code})
+			if err != nil {
+				t.Errorf("VerifyEmail with the mailed code: %s", err)
+			}
+		})
+	}
+}
+
+func TestVerifyEmailDoesNotRevealAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		code    string
+		wantErr error
+	}{
+		{"right code", unverifiedEmail, "123456", nil},
+		{"wrong code", unverifiedEmail, "654321", domainErrors.ErrVerificationCodeInvalid},
+		{"verified account", verifiedEmail, "123456", domainErrors.ErrVerificationCodeInvalid},
+		{"unknown email", unknownEmail, "123456", domainErrors.ErrVerificationCodeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newUsersServiceFixture(time.Minute)
+
+			err := fixture.service.VerifyEmail(context.Background(), &requests.VerifyEmailRequest{Email: tt.email, Code: tt.code})
+			if !errors.Is(err, tt.wantErr) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("VerifyEmail error = %v, want exactly %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestForgotPasswordDoesNotRevealAccounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		wantMail bool
+	}{
+		{"known account", verifiedEmail, true},
+		{"unknown email", unknownEmail, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newUsersServiceFixture(time.Minute)
+
+			err := fixture.service.ForgotPassword(context.Background(), &requests.ForgotPasswordRequest{Email: tt.email})
+			if err != nil {
+				t.Fatalf("ForgotPassword: %s", err)
+			}
+
+			if !tt.wantMail {
+				if messages := fixture.mailer.Messages(); len(messages) > 0 {
+					t.Errorf("mails were sent: %+v", messages)
+				}
+				return
+			}
+
+			// Only the hash is stored, the mail carries the token itself
+			message := waitForMail(t, fixture.mailer, tt.email)
+			if len(fixture.resets.resets) != 1 {
+				t.Fatalf("%d resets stored, want 1", len(fixture.resets.resets))
+			}
+			reset := fixture.resets.resets[0]
+			if reset.TokenHash == "" || strings.Contains(message.Body, reset.TokenHash) {
+				t.Errorf("mail carries the stored hash instead of the token: %q", message.Body)
+			}
+			if !strings.Contains(message.Body, reset.Token) {
+				t.Errorf("mail %q doesn't carry the reset token", message.Body)
+			}
+		})
+	}
+}
+
+type replayedRefreshTokensStubRepository struct {
+	repository.RefreshTokensRepository
+}
+
+func (r *replayedRefreshTokensStubRepository) RotateRefreshToken(ctx context.Context, previousHash string, token *entities.RefreshToken) error {
+	return domainErrors.ErrRefreshTokenReused
+}
+
+type forgetfulSessions struct {
+	Sessions
+	forgotten []string
+}
+
+func (s *forgetfulSessions) Forget(userID string) {
+	s.forgotten = append(s.forgotten, userID)
+}
+
+type usersByIDStubRepository struct {
+	repository.UsersRepository
+}
+
+func (r *usersByIDStubRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	return &entities.User{ID: userID}, nil
+}
+
+func TestRefreshTokenReplayForgetsCachedSessions(t *testing.T) {
+	t.Setenv("USER_ACCESS_TOKEN_SECRET", "access-secret")
+	t.Setenv("USER_REFRESH_TOKEN_SECRET", "refresh-secret")
+	t.Setenv("ACCESS_TOKEN_LIFETIME_MINUTES", "15")
+	jwt, err := helpers.NewJwt(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions := &forgetfulSessions{}
+	s := NewUsersService(&usersByIDStubRepository{}, nil, nil, &replayedRefreshTokensStubRepository{}, nil, nil, sessions, jwt, nil, testCodeLength)
+
+	token, err := jwt.CreateRefreshToken(helpers.UserRefreshTokenClaims{UserId: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.RefreshToken(context.Background(), &requests.RefreshTokenRequest{RefreshToken: token.RefreshToken})
+	if !errors.Is(err, domainErrors.ErrRefreshTokenReused) {
+		t.Fatalf("RefreshToken error = %v, want %v", err, domainErrors.ErrRefreshTokenReused)
+	}
+	if len(sessions.forgotten) != 1 || sessions.forgotten[0] != "1" {
+		t.Errorf("forgotten users = %v, want the replaying user", sessions.forgotten)
+	}
+}
@@ -0,0 +1,120 @@
+// internal/application/service/venues.service.go
+package service
+
+import (
+	"context"
+	"time"
+	_ "time/tzdata" // Timezones are validated even on hosts without tzdata
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+type Venues interface {
+	CreateVenue(ctx context.Context, input *requests.CreateVenueRequest) (*entities.Venue, error)
+	GetVenues(ctx context.Context, input *requests.GetVenuesRequest) ([]*entities.Venue, error)
+	GetVenueByID(ctx context.Context, input *requests.GetVenueByIDRequest) (*entities.Venue, error)
+	UpdateVenue(ctx context.Context, input *requests.UpdateVenueRequest) (*entities.Venue, error)
+	DeleteVenue(ctx context.Context, input *requests.DeleteVenueRequest) error
+}
+
+type venuesService struct {
+	repo   repository.VenuesRepository
+	policy Policy
+}
+
+func NewVenuesService(repo repository.VenuesRepository, policy Policy) *venuesService {
+	return &venuesService{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+func (s *venuesService) CreateVenue(ctx context.Context, input *requests.CreateVenueRequest) (*entities.Venue, error) {
+	// Verify permissions
+	if err := s.policy.Authorize(ctx, input.Role, values.ResourceVenues, values.ActionCreate, values.ScopeOwn); err != nil {
+		return nil, err
+	}
+
+	if _, err := time.LoadLocation(input.Body.Timezone); err != nil {
+		return nil, domainErrors.ErrInvalidTimezone
+	}
+
+	venue := toVenue(&input.Body)
+	venue.OrganizerID = input.OrganizerID
+
+	if err := s.repo.CreateVenue(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+func (s *venuesService) GetVenues(ctx context.Context, input *requests.GetVenuesRequest) ([]*entities.Venue, error) {
+	return s.repo.GetVenues(ctx, input.City, input.Country)
+}
+
+func (s *venuesService) GetVenueByID(ctx context.Context, input *requests.GetVenueByIDRequest) (*entities.Venue, error) {
+	return s.repo.GetVenueByID(ctx, input.ID)
+}
+
+func (s *venuesService) UpdateVenue(ctx context.Context, input *requests.UpdateVenueRequest) (*entities.Venue, error) {
+	if err := s.checkVenueAccess(ctx, input.ID, input.OrganizerID, input.Role, values.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	if _, err := time.LoadLocation(input.Body.Timezone); err != nil {
+		return nil, domainErrors.ErrInvalidTimezone
+	}
+
+	venue := toVenue(&input.Body)
+	venue.ID = input.ID
+
+	if err := s.repo.UpdateVenue(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+func (s *venuesService) DeleteVenue(ctx context.Context, input *requests.DeleteVenueRequest) error {
+	if err := s.checkVenueAccess(ctx, input.ID, input.OrganizerID, input.Role, values.ActionDelete); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteVenue(ctx, input.ID)
+}
+
+// checkVenueAccess allows roles with the action on any venue, and the organizer who added the venue.
+func (s *venuesService) checkVenueAccess(ctx context.Context, venueID, organizerID, role, action string) error {
+	// Verify permissions
+	scope, err := s.policy.Scope(ctx, role, values.ResourceVenues, action)
+	if err != nil {
+		return err
+	}
+
+	if scope == values.ScopeOwn {
+		if err := s.repo.ValidateVenueOwnership(ctx, venueID, organizerID); err != nil {
+			return types.ErrNotAuthorized
+		}
+	}
+
+	return nil
+}
+
+func toVenue(body *requests.VenueRequestBody) *entities.Venue {
+	return &entities.Venue{
+		Name:            body.Name,
+		Address:         body.Address,
+		City:            body.City,
+		Country:         body.Country,
+		Latitude:        body.Latitude,
+		Longitude:       body.Longitude,
+		Timezone:        body.Timezone,
+		DefaultCapacity: body.DefaultCapacity,
+	}
+}
@@ -0,0 +1,22 @@
+// internal/application/types/requests/categories.go
+package requests
+
+type CategoryRequestBody struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type CreateCategoryRequest struct {
+	Body CategoryRequestBody
+	Role string
+}
+
+type UpdateCategoryRequest struct {
+	Body CategoryRequestBody
+	ID   string
+	Role string
+}
+
+type DeleteCategoryRequest struct {
+	ID   string
+	Role string
+}
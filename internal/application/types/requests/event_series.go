@@ -0,0 +1,58 @@
+// internal/application/types/requests/event_series.go
+package requests
+
+import (
+	"time"
+)
+
+type EventSeriesRequestBody struct {
+	Title           string      `json:"title" binding:"required"`
+	Description     string      `json:"description" binding:"required"`
+	Location        string      `json:"location" binding:"required_without=VenueID"`
+	VenueID         string      `json:"venue_id" binding:"omitempty,uuid"`
+	CategoryID      string      `json:"category_id" binding:"omitempty,uuid"`
+	StartsAt        time.Time   `json:"starts_at" binding:"required"`                       // First occurrence
+	DurationMinutes int         `json:"duration_minutes" binding:"required,gt=0,lte=10080"` // Up to a week
+	Timezone        string      `json:"timezone"`                                           // IANA name, UTC when empty
+	RRule           string      `json:"rrule" binding:"required,max=255"`                   // e.g. FREQ=WEEKLY;BYDAY=FR
+	Exceptions      []time.Time `json:"exceptions" binding:"omitempty,max=366"`             // Start times of left out occurrences
+	Capacity        int         `json:"capacity" binding:"required,gt=0"`
+	Price           float64     `json:"price" binding:"required,gte=0"`
+
+	OrganizationID string `json:"organization_id" binding:"omitempty,uuid"` // Set on creation, the organizer's own organization when empty
+
+	ReservationTTLMinutes int  `json:"reservation_ttl_minutes" binding:"omitempty,gte=1,lte=1440"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes" binding:"omitempty,gte=-43200,lte=43200"`
+	RefundPercent         int  `json:"refund_percent" binding:"gte=0,lte=100"`
+	RefundDeadlineHours   int  `json:"refund_deadline_hours" binding:"gte=0"`
+}
+
+type CreateEventSeriesRequest struct {
+	Body        EventSeriesRequestBody
+	OrganizerID string
+	Role        string
+}
+
+type UpdateEventSeriesRequest struct {
+	Body        EventSeriesRequestBody
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
+type GetEventSeriesRequest struct {
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
+type GetEventSeriesByOrganizerRequest struct {
+	OrganizerID string
+	Role        string
+}
+
+type PublishEventSeriesRequest struct {
+	ID          string
+	OrganizerID string
+	Role        string
+}
@@ -8,10 +8,21 @@ import (
 type CreateEventRequestBody struct {
 	Title       string    `json:"title" binding:"required"`
 	Description string    `json:"description" binding:"required"`
-	Location    string    `json:"location" binding:"required"`
-	Date        time.Time `json:"date" binding:"required"`
+	Location    string    `json:"location" binding:"required_without=VenueID"`
+	VenueID     string    `json:"venue_id" binding:"omitempty,uuid"`
+	CategoryID  string    `json:"category_id" binding:"omitempty,uuid"`
+	Tags        []string  `json:"tags" binding:"omitempty,max=10,dive,required,max=50"`
+	StartsAt    time.Time `json:"starts_at" binding:"required"`
+	EndsAt      time.Time `json:"ends_at" binding:"required,gtfield=StartsAt"`
 	Capacity    int       `json:"capacity" binding:"required,gt=0"`
 	Price       float64   `json:"price" binding:"required,gte=0"`
+
+	OrganizationID string `json:"organization_id" binding:"omitempty,uuid"` // The organizer's own organization when empty
+
+	ReservationTTLMinutes int  `json:"reservation_ttl_minutes" binding:"omitempty,gte=1,lte=1440"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes" binding:"omitempty,gte=-43200,lte=43200"` // Relative to the start, empty sells until the end
+	RefundPercent         int  `json:"refund_percent" binding:"gte=0,lte=100"`
+	RefundDeadlineHours   int  `json:"refund_deadline_hours" binding:"gte=0"`
 }
 
 type CreateEventRequest struct {
@@ -23,10 +34,19 @@ type CreateEventRequest struct {
 type UpdateEventRequestBody struct {
 	Title       string    `json:"title" binding:"required"`
 	Description string    `json:"description" binding:"required"`
-	Location    string    `json:"location" binding:"required"`
-	Date        time.Time `json:"date" binding:"required"`
+	Location    string    `json:"location" binding:"required_without=VenueID"`
+	VenueID     string    `json:"venue_id" binding:"omitempty,uuid"`
+	CategoryID  string    `json:"category_id" binding:"omitempty,uuid"`
+	Tags        []string  `json:"tags" binding:"omitempty,max=10,dive,required,max=50"`
+	StartsAt    time.Time `json:"starts_at" binding:"required"`
+	EndsAt      time.Time `json:"ends_at" binding:"required,gtfield=StartsAt"`
 	Capacity    int       `json:"capacity" binding:"required,gt=0"`
 	Price       float64   `json:"price" binding:"required,gte=0"`
+
+	ReservationTTLMinutes int  `json:"reservation_ttl_minutes" binding:"omitempty,gte=1,lte=1440"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes" binding:"omitempty,gte=-43200,lte=43200"` // Relative to the start, empty sells until the end
+	RefundPercent         int  `json:"refund_percent" binding:"gte=0,lte=100"`
+	RefundDeadlineHours   int  `json:"refund_deadline_hours" binding:"gte=0"`
 }
 
 type UpdateEventRequest struct {
@@ -36,15 +56,47 @@ type UpdateEventRequest struct {
 	Body        UpdateEventRequestBody
 }
 
+// EventsQuery holds the search, filter, sort and paging query parameters of event listings
+type EventsQuery struct {
+	Search   string     `form:"q" binding:"omitempty,max=200"`
+	Location string     `form:"location" binding:"omitempty,max=200"`
+	VenueID  string     `form:"venue_id" binding:"omitempty,uuid"`
+	Category string     `form:"category_id" binding:"omitempty,uuid"`
+	Tags     []string   `form:"tag" binding:"omitempty,max=5,dive,required,max=50"`
+	DateFrom *time.Time `form:"date_from"`
+	DateTo   *time.Time `form:"date_to"`
+	MinPrice *float64   `form:"min_price" binding:"omitempty,gte=0"`
+	MaxPrice *float64   `form:"max_price" binding:"omitempty,gte=0"`
+	Sort     string     `form:"sort" binding:"omitempty,oneof=relevance date -date price -price title -title created_at -created_at"`
+	Limit    int        `form:"limit" binding:"omitempty,gte=1,lte=100"`
+	Offset   int        `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// NearbyEventsQuery holds the query parameters of the nearby events listing, radius is in kilometers
+type NearbyEventsQuery struct {
+	Lat    *float64 `form:"lat" binding:"required,latitude"`
+	Lng    *float64 `form:"lng" binding:"required,longitude"`
+	Radius float64  `form:"radius" binding:"omitempty,gt=0,lte=500"`
+	Limit  int      `form:"limit" binding:"omitempty,gte=1,lte=100"`
+	Offset int      `form:"offset" binding:"omitempty,gte=0"`
+}
+
+type GetNearbyEventsRequest struct {
+	Role  string
+	Query NearbyEventsQuery
+}
+
 type GetEventsByOrganizerRequest struct {
 	OrganizerID string
 	Role        string
 	Status      string
+	Query       EventsQuery
 }
 
 type GetEventsRequest struct {
-	Status string
-	Role   string
+	Statuses []string
+	Role     string
+	Query    EventsQuery
 }
 
 type GetEventByIDRequest struct {
@@ -59,8 +111,42 @@ type DeleteEventRequest struct {
 	Role        string
 }
 
+type PublishEventRequestBody struct {
+	PublishAt *time.Time `json:"publish_at"` // Publish later instead of now
+}
+
+type PublishEventRequest struct {
+	Body        PublishEventRequestBody
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
 type CancelEventRequest struct {
 	ID          string
 	OrganizerID string
 	Role        string
 }
+
+type ApproveEventRequest struct {
+	ID      string
+	AdminID string
+	Role    string
+}
+
+type RejectEventRequestBody struct {
+	Reason string `json:"reason" binding:"required,max=1000"` // Shown to the organizer
+}
+
+type RejectEventRequest struct {
+	Body    RejectEventRequestBody
+	ID      string
+	AdminID string
+	Role    string
+}
+
+type GetEventReviewsRequest struct {
+	ID          string
+	OrganizerID string
+	Role        string
+}
@@ -0,0 +1,69 @@
+// internal/application/types/requests/organizations.go
+package requests
+
+type CreateOrganizationRequestBody struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type CreateOrganizationRequest struct {
+	Body   CreateOrganizationRequestBody
+	UserID string
+	Role   string
+}
+
+type GetOrganizationsRequest struct {
+	UserID string
+	Role   string
+}
+
+type GetOrganizationRequest struct {
+	ID     string
+	UserID string
+	Role   string
+}
+
+type InviteMemberRequestBody struct {
+	Email string `json:"email" binding:"required,email,max=64"`
+	Role  string `json:"role" binding:"required,oneof=manager box_office viewer"`
+}
+
+type InviteMemberRequest struct {
+	Body   InviteMemberRequestBody
+	ID     string
+	UserID string
+	Role   string
+}
+
+type GetInvitationsRequest struct {
+	ID     string
+	UserID string
+	Role   string
+}
+
+type AcceptInvitationRequestBody struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type AcceptInvitationRequest struct {
+	Body   AcceptInvitationRequestBody
+	UserID string
+}
+
+type UpdateMemberRoleRequestBody struct {
+	Role string `json:"role" binding:"required,oneof=manager box_office viewer"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Body     UpdateMemberRoleRequestBody
+	ID       string
+	MemberID string
+	UserID   string
+	Role     string
+}
+
+type RemoveMemberRequest struct {
+	ID       string
+	MemberID string
+	UserID   string
+	Role     string
+}
@@ -0,0 +1,54 @@
+// internal/application/types/requests/payments.go
+package requests
+
+type CheckoutRequestBody struct {
+	TicketIDs []string `json:"ticket_ids" binding:"required,min=1,dive,uuid"`
+}
+
+type CheckoutRequest struct {
+	Body   CheckoutRequestBody
+	UserID string
+	Role   string
+}
+
+type ConfirmPaymentRequest struct {
+	PaymentID string
+	UserID    string
+	Role      string
+}
+
+type GetPaymentByIDRequest struct {
+	PaymentID string
+	UserID    string
+	Role      string
+}
+
+type GetUserPaymentsRequest struct {
+	UserID string
+	Role   string
+	Status string
+}
+
+type PaymentWebhookRequest struct {
+	Payload   []byte
+	Signature string
+}
+
+type RequestRefundRequest struct {
+	TicketID string
+	UserID   string
+	Role     string
+}
+
+type GetEventRefundsRequest struct {
+	EventID     string
+	OrganizerID string
+	Role        string
+	Status      string
+}
+
+type GetUserRefundsRequest struct {
+	UserID string
+	Role   string
+	Status string
+}
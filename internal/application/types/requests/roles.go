@@ -0,0 +1,44 @@
+// internal/application/types/requests/roles.go
+package requests
+
+type CreateRoleRequestBody struct {
+	Name        string   `json:"name" binding:"required,max=50"`
+	Description string   `json:"description" binding:"max=255"`
+	Permissions []string `json:"permissions" binding:"required,min=1,dive,required"` // resource:action:scope, e.g. events:read:any
+}
+
+type CreateRoleRequest struct {
+	Body CreateRoleRequestBody
+	Role string
+}
+
+type GetRolesRequest struct {
+	Role string
+}
+
+type UpdateRoleRequestBody struct {
+	Description string   `json:"description" binding:"max=255"`
+	Permissions []string `json:"permissions" binding:"required,min=1,dive,required"`
+}
+
+type UpdateRoleRequest struct {
+	Body UpdateRoleRequestBody
+	Name string
+	Role string
+}
+
+type DeleteRoleRequest struct {
+	Name string
+	Role string
+}
+
+type AssignUserRoleRequestBody struct {
+	Role string `json:"role" binding:"required,max=50"`
+}
+
+type AssignUserRoleRequest struct {
+	Body   AssignUserRoleRequestBody
+	ID     string
+	UserID string
+	Role   string
+}
@@ -0,0 +1,53 @@
+// internal/application/types/requests/seat_maps.go
+package requests
+
+type CreateSeatMapRequestBody struct {
+	Name     string                   `json:"name" binding:"required,max=255"`
+	VenueID  string                   `json:"venue_id" binding:"omitempty,uuid"` // Venue the layout was drawn for
+	Sections []SeatSectionRequestBody `json:"sections" binding:"required,min=1,dive"`
+}
+
+type SeatSectionRequestBody struct {
+	Name string               `json:"name" binding:"required,max=100"`
+	Rows []SeatRowRequestBody `json:"rows" binding:"required,min=1,dive"`
+}
+
+// SeatRowRequestBody describes a row of seats numbered from 1 to Seats.
+type SeatRowRequestBody struct {
+	Label string `json:"label" binding:"required,max=20"`
+	Seats int    `json:"seats" binding:"required,gt=0,lte=500"`
+}
+
+type CreateSeatMapRequest struct {
+	Body        CreateSeatMapRequestBody
+	OrganizerID string
+	Role        string
+}
+
+type GetSeatMapByIDRequest struct {
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
+type GetOrganizerSeatMapsRequest struct {
+	OrganizerID string
+	Role        string
+}
+
+type AttachSeatMapRequestBody struct {
+	SeatMapID string `json:"seat_map_id" binding:"required,uuid"`
+}
+
+type AttachSeatMapRequest struct {
+	Body        AttachSeatMapRequestBody
+	EventID     string
+	OrganizerID string
+	Role        string
+}
+
+type GetEventSeatsRequest struct {
+	EventID string
+	UserID  string
+	Role    string
+}
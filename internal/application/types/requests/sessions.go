@@ -0,0 +1,16 @@
+// internal/application/types/requests/sessions.go
+package requests
+
+type LogoutRequest struct {
+	UserID    string
+	SessionID string
+}
+
+type LogoutAllRequest struct {
+	UserID string
+}
+
+type ForceLogoutRequest struct {
+	ID   string
+	Role string
+}
@@ -0,0 +1,42 @@
+// internal/application/types/requests/ticket_types.go
+package requests
+
+import (
+	"time"
+)
+
+type TicketTypeRequestBody struct {
+	Name         string     `json:"name" binding:"required,max=100"`
+	Price        float64    `json:"price" binding:"gte=0"`
+	Capacity     int        `json:"capacity" binding:"required,gt=0"`
+	SalesStartAt *time.Time `json:"sales_start_at"`
+	SalesEndAt   *time.Time `json:"sales_end_at"`
+	MaxPerUser   int        `json:"max_per_user" binding:"gte=0"`
+}
+
+type CreateTicketTypeRequest struct {
+	Body        TicketTypeRequestBody
+	EventID     string
+	OrganizerID string
+	Role        string
+}
+
+type UpdateTicketTypeRequest struct {
+	Body        TicketTypeRequestBody
+	ID          string
+	EventID     string
+	OrganizerID string
+	Role        string
+}
+
+type DeleteTicketTypeRequest struct {
+	ID          string
+	EventID     string
+	OrganizerID string
+	Role        string
+}
+
+type GetEventTicketTypesRequest struct {
+	EventID string
+	Role    string
+}
@@ -9,7 +9,17 @@ type ReserveTicketsRequest struct {
 }
 
 type ReserveTicketsRequestBody struct {
-	Quantity int    `json:"quantity" binding:"required,gt=0"`
+	Quantity int                 `json:"quantity" binding:"required_without_all=Items SeatIDs,omitempty,gt=0"` // Tickets at the event's base price
+	SeatIDs  []string            `json:"seat_ids" binding:"omitempty,min=1,dive,uuid"`                         // Seats at the event's base price
+	Items    []ReserveTicketItem `json:"items" binding:"omitempty,min=1,dive"`                                 // Tickets of specific ticket types
+}
+
+// ReserveTicketItem picks tickets of one type, either by quantity or, for
+// events with reserved seating, by seats.
+type ReserveTicketItem struct {
+	TicketTypeID string   `json:"ticket_type_id" binding:"required,uuid"`
+	Quantity     int      `json:"quantity" binding:"required_without=SeatIDs,omitempty,gt=0"`
+	SeatIDs      []string `json:"seat_ids" binding:"omitempty,min=1,dive,uuid"`
 }
 
 
@@ -33,3 +33,78 @@ type OrganizerSignInRequest struct {
 	Email    string `json:"email" binding:"required,email,max=64"`
 	Password string `json:"password" binding:"required,min=8,max=64"`
 }
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Email string `json:"email" binding:"required,email,max=64"`
+	Code  string `json:"code" binding:"required,numeric,max=16"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email,max=64"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email,max=64"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required,max=128"`
+	Password string `json:"password" binding:"required,min=8,max=64"`
+}
+
+type GetProfileRequest struct {
+	UserID string
+}
+
+// UpdateProfileRequestBody changes the fields that are set, the rules match the sign-up ones
+type UpdateProfileRequestBody struct {
+	Name    *string `json:"name" binding:"omitempty,min=3,max=32"`
+	Address *string `json:"address" binding:"omitempty,min=3,max=128"`
+	Phone   *string `json:"phone" binding:"omitempty,min=10,max=20"`
+}
+
+type UpdateProfileRequest struct {
+	Body   UpdateProfileRequestBody
+	UserID string
+}
+
+type ChangePasswordRequestBody struct {
+	CurrentPassword string `json:"current_password" binding:"required,max=64"`
+	NewPassword     string `json:"new_password" binding:"required,min=8,max=64"`
+}
+
+type ChangePasswordRequest struct {
+	Body      ChangePasswordRequestBody
+	UserID    string
+	SessionID string
+}
+
+type GetOrganizersRequest struct {
+	Status string
+	Role   string
+}
+
+type ApproveOrganizerRequest struct {
+	ID   string
+	Role string
+}
+
+type OrganizerStatusRequestBody struct {
+	Reason string `json:"reason" binding:"required,max=1000"` // Shown to the organizer
+}
+
+type RejectOrganizerRequest struct {
+	Body OrganizerStatusRequestBody
+	ID   string
+	Role string
+}
+
+type SuspendOrganizerRequest struct {
+	Body OrganizerStatusRequestBody
+	ID   string
+	Role string
+}
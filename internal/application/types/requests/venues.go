@@ -0,0 +1,41 @@
+// internal/application/types/requests/venues.go
+package requests
+
+type VenueRequestBody struct {
+	Name            string   `json:"name" binding:"required,max=255"`
+	Address         string   `json:"address" binding:"required,max=255"`
+	City            string   `json:"city" binding:"required,max=100"`
+	Country         string   `json:"country" binding:"required,max=100"`
+	Latitude        *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,latitude"`
+	Longitude       *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,longitude"`
+	Timezone        string   `json:"timezone" binding:"required"`
+	DefaultCapacity int      `json:"default_capacity" binding:"required,gt=0"`
+}
+
+type CreateVenueRequest struct {
+	Body        VenueRequestBody
+	OrganizerID string
+	Role        string
+}
+
+type UpdateVenueRequest struct {
+	Body        VenueRequestBody
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
+type DeleteVenueRequest struct {
+	ID          string
+	OrganizerID string
+	Role        string
+}
+
+type GetVenuesRequest struct {
+	City    string
+	Country string
+}
+
+type GetVenueByIDRequest struct {
+	ID string
+}
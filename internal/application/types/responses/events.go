@@ -1,8 +1,30 @@
 // internal/application/types/responses/events.go
 package responses
 
+import "ticket-booking-app-backend/internal/domain/entities"
+
 type EventResponse struct {
     Success bool        `json:"success"`
     Message string      `json:"message"`
     Event   interface{} `json:"event,omitempty"`
-}
\ No newline at end of file
+}
+
+// EventsPage is one page of an event listing
+type EventsPage struct {
+    Events     []*entities.Event                  `json:"events"`
+    Highlights map[string]entities.EventHighlight `json:"highlights,omitempty"` // Search matches by event ID, set when searching
+    Facets     *entities.EventFacets              `json:"facets"`
+    Total      int64                              `json:"total"`                // Number of events matching the query
+    Limit      int                                `json:"limit"`
+    Offset     int                                `json:"offset"`
+    Next       string                             `json:"next,omitempty"` // Link to the next page, empty on the last one
+}
+
+// NearbyEventsPage is one page of the events around a point, closest first
+type NearbyEventsPage struct {
+    Events []*entities.NearbyEvent `json:"events"`
+    Total  int64                   `json:"total"`
+    Limit  int                     `json:"limit"`
+    Offset int                     `json:"offset"`
+    Next   string                  `json:"next,omitempty"`
+}
@@ -5,4 +5,6 @@ type TokenResponse struct {
 	Token                 string `json:"token"`
 	TokenType             string `json:"token_type"`
 	ExpiresAt             int64  `json:"expires_at"`
+	RefreshToken          string `json:"refresh_token"`
+	RefreshTokenExpiresAt int64  `json:"refresh_token_expires_at"`
 }
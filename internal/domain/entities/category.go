@@ -0,0 +1,13 @@
+package entities
+
+import (
+	"time"
+)
+
+// Category is an admin-managed classification of events, e.g. concerts or theatre.
+type Category struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"` // URL-friendly form of the name, unique
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"time"
+)
+
+// EmailVerification is the code a user confirms their email address with. Only a hash of
+// the code is stored, users without a pending verification are verified.
+type EmailVerification struct {
+	UserID    string    `json:"user_id"`
+	Code      string    `json:"-"` // Only known when the code is created
+	CodeHash  string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	SentAt    time.Time `json:"sent_at"`
+	Attempts  int       `json:"attempts"`
+}
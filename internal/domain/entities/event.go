@@ -5,15 +5,32 @@ import (
 )
 
 type Event struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Location    string    `json:"location"`
-	Date        time.Time `json:"date"`
-	Capacity    int       `json:"capacity"`
-	TicketsSold int       `json:"tickets_sold"`
-	Price       float64   `json:"price"`
-	Status      string    `json:"status"`
-	Tickets     []*Ticket `json:"tickets"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Location    string     `json:"location"`
+	VenueID     string     `json:"venue_id,omitempty"`
+	CategoryID  string     `json:"category_id,omitempty"`
+	Tags        []string   `json:"tags,omitempty"` // Free-form labels set by the organizer
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      time.Time  `json:"ends_at"`
+	Capacity    int        `json:"capacity"`
+	TicketsSold int        `json:"tickets_sold"`
+	Price       float64    `json:"price"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"` // When a scheduled event gets published
+	Tickets     []*Ticket  `json:"tickets"`
+	SeatMapID   string     `json:"seat_map_id,omitempty"` // Set for events with reserved seating
+	SeriesID    string     `json:"series_id,omitempty"`   // Set for occurrences of an event series
+	CreatedAt   time.Time  `json:"created_at"`
+
+	OrganizationID string `json:"organization_id,omitempty"` // Its members manage the event according to their role
+
+	ReservationTTLMinutes int  `json:"reservation_ttl_minutes"`        // How long unpaid reservations are held
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes,omitempty"` // Sales close this many minutes after the start, negative for before it. Empty sells until the end
+
+	// Refund policy for user-initiated refunds
+	RefundPercent       int `json:"refund_percent"`        // Share of the ticket price refunded, 0 disables refunds
+	RefundDeadlineHours int `json:"refund_deadline_hours"` // Refunds close this many hours before the event
+
 }
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+)
+
+// EventFilter narrows down, orders and pages an event listing.
+// Zero values mean "no constraint".
+type EventFilter struct {
+	Statuses    []string // Events in any of them
+	OrganizerID string   // Events the user created or may see through their organizations
+	Search      string   // Full-text and fuzzy search over title and description, see SearchRepository
+	Location    string   // Matched against the location text
+	VenueID     string
+	CategoryID  string
+	Tags        []string // Events must carry all of them
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	PriceMin    *float64
+	PriceMax    *float64
+
+	SortBy   string // One of the values.EventSort* fields
+	SortDesc bool
+
+	Limit  int
+	Offset int
+}
+
+// FacetCount is the number of events sharing one value of a facet
+type FacetCount struct {
+	Value string `json:"value"`           // Category ID or tag
+	Label string `json:"label,omitempty"` // Display name, for categories
+	Count int64  `json:"count"`
+}
+
+// EventFacets counts the events of a listing per category and per tag. Category counts
+// ignore the category filter so the other categories can still be offered.
+type EventFacets struct {
+	Categories []FacetCount `json:"categories"`
+	Tags       []FacetCount `json:"tags"`
+}
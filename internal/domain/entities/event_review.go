@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"time"
+)
+
+// EventReview is one entry of an event's moderation history.
+type EventReview struct {
+	ID         string    `json:"id"`
+	EventID    string    `json:"event_id"`
+	ReviewerID string    `json:"reviewer_id"`      // Organizer who submitted or admin who decided
+	Action     string    `json:"action"`           // Submitted, approved or rejected
+	Reason     string    `json:"reason,omitempty"` // Why it was rejected, or what an edit changed
+	CreatedAt  time.Time `json:"created_at"`
+}
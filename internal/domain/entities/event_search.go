@@ -0,0 +1,19 @@
+package entities
+
+// EventHighlight tells why an event matched a search
+type EventHighlight struct {
+	Rank    float64 `json:"rank"`
+	Title   string  `json:"title"`   // HTML-escaped title with the matched terms wrapped in <mark>
+	Snippet string  `json:"snippet"` // HTML-escaped description excerpt with the matched terms wrapped in <mark>
+}
+
+type EventSearchHit struct {
+	Event     *Event
+	Highlight EventHighlight
+}
+
+// NearbyEvent is an event found around a point, with how far away it takes place
+type NearbyEvent struct {
+	*Event
+	DistanceKm float64 `json:"distance_km"`
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"time"
+
+	"ticket-booking-app-backend/pkg/recurrence"
+)
+
+// EventSeries is a recurring event, its occurrences are materialized as regular events
+// that share the series settings.
+type EventSeries struct {
+	ID              string      `json:"id"`
+	OrganizerID     string      `json:"organizer_id"`
+	OrganizationID  string      `json:"organization_id,omitempty"`
+	Title           string      `json:"title"`
+	Description     string      `json:"description"`
+	Location        string      `json:"location"`
+	VenueID         string      `json:"venue_id,omitempty"`
+	CategoryID      string      `json:"category_id,omitempty"`
+	StartsAt        time.Time   `json:"starts_at"`            // First occurrence, the DTSTART of the rule
+	DurationMinutes int         `json:"duration_minutes"`     // Length of every occurrence
+	Timezone        string      `json:"timezone"`             // IANA name the rule is expanded in, e.g. Asia/Almaty
+	RRule           string      `json:"rrule"`                // RFC 5545 rule, e.g. FREQ=WEEKLY;BYDAY=FR
+	Exceptions      []time.Time `json:"exceptions,omitempty"` // Occurrences left out of the series, the EXDATEs
+	Capacity        int         `json:"capacity"`
+	Price           float64     `json:"price"`
+	Status          string      `json:"status"` // Draft, pending review or published, new occurrences are created with it
+	CreatedAt       time.Time   `json:"created_at"`
+
+	ReservationTTLMinutes int  `json:"reservation_ttl_minutes"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes,omitempty"`
+	RefundPercent         int  `json:"refund_percent"`
+	RefundDeadlineHours   int  `json:"refund_deadline_hours"`
+
+	Occurrences []*Event `json:"occurrences,omitempty"` // Upcoming occurrences, filled when a single series is read
+}
+
+// OccurrencesBetween returns the start times of the series' occurrences within [from, to]
+func (s *EventSeries) OccurrencesBetween(from, to time.Time) ([]time.Time, error) {
+	rule, err := recurrence.Parse(s.RRule)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.UTC
+	if s.Timezone != "" {
+		if location, err = time.LoadLocation(s.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	return rule.Between(s.StartsAt.In(location), from, to, s.Exceptions), nil
+}
+
+// OccurrencePositions numbers the instances of the series' rule up to the given time by their
+// position in the rule, keyed by their Unix start time. Exceptions keep their position.
+func (s *EventSeries) OccurrencePositions(to time.Time) (map[int64]int, error) {
+	rule, err := recurrence.Parse(s.RRule)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.UTC
+	if s.Timezone != "" {
+		if location, err = time.LoadLocation(s.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	dtstart := s.StartsAt.In(location)
+	instances := rule.Between(dtstart, dtstart, to, nil)
+	positions := make(map[int64]int, len(instances))
+	for i, instance := range instances {
+		positions[instance.Unix()] = i
+	}
+	return positions, nil
+}
+
+// OccurrenceEnd is when an occurrence starting at startsAt ends
+func (s *EventSeries) OccurrenceEnd(startsAt time.Time) time.Time {
+	return startsAt.Add(time.Duration(s.DurationMinutes) * time.Minute)
+}
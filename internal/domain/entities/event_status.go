@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// eventTransitions lists the statuses an event can move to from each status.
+// Finished and cancelled events are final.
+// Under moderation, organizers' events pass through pending review on their way to going live
+// and go back to it when they are significantly edited. Suspending an organizer unpublishes
+// their events back to draft.
+var eventTransitions = map[string][]string{
+	values.EventStatusDraft:         {values.EventStatusPendingReview, values.EventStatusScheduled, values.EventStatusPublished},
+	values.EventStatusPendingReview: {values.EventStatusDraft, values.EventStatusScheduled, values.EventStatusPublished, values.EventStatusCancelled},
+	values.EventStatusScheduled:     {values.EventStatusDraft, values.EventStatusPendingReview, values.EventStatusPublished, values.EventStatusCancelled},
+	values.EventStatusPublished:     {values.EventStatusDraft, values.EventStatusPendingReview, values.EventStatusOngoing, values.EventStatusFinished, values.EventStatusCancelled},
+	values.EventStatusOngoing:       {values.EventStatusFinished, values.EventStatusCancelled},
+}
+
+// PublicEventStatuses are the statuses of events everyone can see and buy tickets for
+var PublicEventStatuses = []string{values.EventStatusPublished, values.EventStatusOngoing}
+
+// IsPublicEventStatus tells whether an event in this status is visible to everyone
+func IsPublicEventStatus(status string) bool {
+	for _, public := range PublicEventStatuses {
+		if status == public {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitionEvent tells whether an event may move from one status to another
+func CanTransitionEvent(from, to string) bool {
+	for _, status := range eventTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalEventStatus tells whether an event has reached the end of its lifecycle
+func IsFinalEventStatus(status string) bool {
+	return len(eventTransitions[status]) == 0
+}
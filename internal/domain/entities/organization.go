@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"time"
+
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// Organization is a team of organizers sharing its events, its members act on them
+// according to their role.
+type Organization struct {
+	ID        string                `json:"id"`
+	Name      string                `json:"name"`
+	OwnerID   string                `json:"owner_id"`
+	Role      string                `json:"role,omitempty"` // Role of the user the organization was read for
+	CreatedAt time.Time             `json:"created_at"`
+	Members   []*OrganizationMember `json:"members,omitempty"` // Filled when a single organization is read
+}
+
+type OrganizationMember struct {
+	OrganizationID string    `json:"organization_id"`
+	UserID         string    `json:"user_id"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
+
+// OrganizationInvitation asks someone to join an organization, it's accepted with a token
+// addressed to the invited email. Only a hash of the token is stored.
+type OrganizationInvitation struct {
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	InvitedBy      string     `json:"invited_by"`
+	Token          string     `json:"-"` // Only known when the invitation is created, it's mailed to the invitee
+	TokenHash      string     `json:"-"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	AcceptedAt     *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// organizationPermissions lists what the members of an organization may do with each role
+var organizationPermissions = map[string][]string{
+	values.OrganizationRoleOwner: {
+		values.OrganizationPermissionManageMembers,
+		values.OrganizationPermissionManageEvents,
+		values.OrganizationPermissionManageTickets,
+		values.OrganizationPermissionViewEvents,
+	},
+	values.OrganizationRoleManager: {
+		values.OrganizationPermissionManageEvents,
+		values.OrganizationPermissionManageTickets,
+		values.OrganizationPermissionViewEvents,
+	},
+	values.OrganizationRoleBoxOffice: {
+		values.OrganizationPermissionManageTickets,
+		values.OrganizationPermissionViewEvents,
+	},
+	values.OrganizationRoleViewer: {
+		values.OrganizationPermissionViewEvents,
+	},
+}
+
+// IsOrganizationRole tells whether the role is one a member can hold
+func IsOrganizationRole(role string) bool {
+	_, ok := organizationPermissions[role]
+	return ok
+}
+
+// OrganizationRoleAllows tells whether a member with the role has the permission
+func OrganizationRoleAllows(role, permission string) bool {
+	for _, granted := range organizationPermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// OrganizationRolesWith lists the roles that have the permission
+func OrganizationRolesWith(permission string) []string {
+	var roles []string
+	for _, role := range []string{
+		values.OrganizationRoleOwner,
+		values.OrganizationRoleManager,
+		values.OrganizationRoleBoxOffice,
+		values.OrganizationRoleViewer,
+	} {
+		if OrganizationRoleAllows(role, permission) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// IsExpired tells whether the invitation can't be accepted anymore
+func (i *OrganizationInvitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+)
+
+// PasswordReset lets a user who forgot their password set a new one with a token mailed
+// to them. Only a hash of the token is stored.
+type PasswordReset struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Token     string     `json:"-"` // Only known when the reset is created
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -4,11 +4,31 @@ import (
 	"time"
 )
 
-// Payment represents a payment made for a ticket.
+// Payment represents a payment made for one or more tickets.
 type Payment struct {
-	ID              string    `json:"id"`
-	StripePaymentID string    `json:"stripe_payment_id"`
-	Amount          float64   `json:"amount"`
-	Status          string    `json:"status"`           // Status: 'pending', 'completed', 'failed', 'refunded'
-	CreatedAt       time.Time `json:"created_at"`
+	ID                string    `json:"id"`
+	UserID            string    `json:"user_id"`
+	ProviderPaymentID string    `json:"provider_payment_id"`
+	CheckoutURL       string    `json:"checkout_url"`
+	Amount            float64   `json:"amount"`
+	Currency          string    `json:"currency"`
+	Status            string    `json:"status"` // Status: 'pending', 'completed', 'failed', 'refunded'
+	Tickets           []*Ticket `json:"tickets"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// PaymentWebhookEvent is a provider callback, recorded once it's processed so a retried
+// delivery isn't applied twice.
+type PaymentWebhookEvent struct {
+	ID                string // Provider event ID
+	Type              string
+	ProviderPaymentID string
+}
+
+// ProviderRefund is a refund the payment provider reports for a payment.
+type ProviderRefund struct {
+	ID            string // Provider refund ID
+	RefundID      string // Our refund the provider was asked for, empty for refunds made at the provider
+	Amount        float64
+	RefundedTotal float64 // Everything refunded of the payment so far, this refund included
 }
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"time"
+)
+
+// RefreshToken is handed out at sign-in next to the access token. Every use swaps it for
+// the next token of its family, only a hash of it is stored.
+type RefreshToken struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	FamilyID  string     `json:"family_id"` // Shared by the tokens rotated from the same sign-in
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
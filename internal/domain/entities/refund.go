@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"time"
+)
+
+// Refund represents the refund of a single paid ticket.
+type Refund struct {
+	ID               string    `json:"id"`
+	TicketID         string    `json:"ticket_id"`
+	PaymentID        string    `json:"payment_id"`
+	EventID          string    `json:"event_id"`
+	UserID           string    `json:"user_id"`
+	ProviderRefundID string    `json:"provider_refund_id"`
+	Amount           float64   `json:"amount"`
+	Reason           string    `json:"reason"`
+	Status           string    `json:"status"` // Status: 'pending', 'completed', 'failed'
+	FailureReason    string    `json:"failure_reason,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
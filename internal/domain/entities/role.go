@@ -0,0 +1,180 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// Permission lets a role take an action on a kind of resource, either on any of them
+// or only on the user's own.
+type Permission struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Scope    string `json:"scope"`
+}
+
+// Role is a named set of permissions carried in the access token. The user, organizer
+// and admin roles are built in, admins may define more.
+type Role struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions"`
+	BuiltIn     bool         `json:"built_in"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// permissionActions lists the actions each resource knows
+var permissionActions = map[string][]string{
+	values.ResourceEvents:        {values.ActionCreate, values.ActionRead, values.ActionUpdate, values.ActionDelete, values.ActionPublish, values.ActionCancel, values.ActionReview},
+	values.ResourceEventSeries:   {values.ActionCreate, values.ActionRead, values.ActionUpdate, values.ActionPublish},
+	values.ResourceTicketTypes:   {values.ActionCreate, values.ActionUpdate, values.ActionDelete},
+	values.ResourceSeatMaps:      {values.ActionCreate, values.ActionRead},
+	values.ResourceVenues:        {values.ActionCreate, values.ActionUpdate, values.ActionDelete},
+	values.ResourceCategories:    {values.ActionCreate, values.ActionUpdate, values.ActionDelete},
+	values.ResourceTickets:       {values.ActionRead, values.ActionCancel},
+	values.ResourcePayments:      {values.ActionRead},
+	values.ResourceRefunds:       {values.ActionRead},
+	values.ResourceOrganizers:    {values.ActionRead, values.ActionReview},
+	values.ResourceOrganizations: {values.ActionCreate, values.ActionRead, values.ActionUpdate},
+	values.ResourceRoles:         {values.ActionCreate, values.ActionRead, values.ActionUpdate, values.ActionDelete},
+	values.ResourceUsers:         {values.ActionUpdate},
+}
+
+var (
+	userPermissions = concatPermissions(
+		grant(values.ScopeOwn, values.ResourceTickets, values.ActionRead, values.ActionCancel),
+		grant(values.ScopeOwn, values.ResourcePayments, values.ActionRead),
+	)
+
+	organizerPermissions = concatPermissions(
+		userPermissions,
+		grant(values.ScopeOwn, values.ResourceEvents, values.ActionCreate, values.ActionRead, values.ActionUpdate, values.ActionDelete, values.ActionPublish, values.ActionCancel),
+		grant(values.ScopeOwn, values.ResourceEventSeries, values.ActionCreate, values.ActionRead, values.ActionUpdate, values.ActionPublish),
+		grant(values.ScopeOwn, values.ResourceTicketTypes, values.ActionCreate, values.ActionUpdate, values.ActionDelete),
+		grant(values.ScopeOwn, values.ResourceSeatMaps, values.ActionCreate, values.ActionRead),
+		grant(values.ScopeOwn, values.ResourceVenues, values.ActionCreate, values.ActionUpdate, values.ActionDelete),
+		grant(values.ScopeOwn, values.ResourceRefunds, values.ActionRead),
+		grant(values.ScopeOwn, values.ResourceOrganizations, values.ActionCreate, values.ActionRead, values.ActionUpdate),
+	)
+
+	adminPermissions = grant(values.ScopeAny, values.PermissionWildcard, values.PermissionWildcard)
+)
+
+// builtInRoles are defined in code and can't be changed or removed
+var builtInRoles = []*Role{
+	{Name: values.UserRole, Description: "Buys tickets", Permissions: userPermissions, BuiltIn: true},
+	{Name: values.OrganizerRole, Description: "Runs events on their own or in organizations", Permissions: organizerPermissions, BuiltIn: true},
+	{Name: values.AdminRole, Description: "Manages the whole platform", Permissions: adminPermissions, BuiltIn: true},
+}
+
+// BuiltInRoles lists the roles defined in code
+func BuiltInRoles() []*Role {
+	return builtInRoles
+}
+
+// BuiltInRole returns the built-in role with the name
+func BuiltInRole(name string) (*Role, bool) {
+	for _, role := range builtInRoles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return nil, false
+}
+
+// Scope returns the widest scope the role may take the action on the resource in,
+// empty when it may not take it at all
+func (r *Role) Scope(resource, action string) string {
+	scope := ""
+	for _, permission := range r.Permissions {
+		if !permission.covers(resource, action) {
+			continue
+		}
+		if permission.Scope == values.ScopeAny {
+			return values.ScopeAny
+		}
+		scope = values.ScopeOwn
+	}
+	return scope
+}
+
+// ScopeCovers tells whether a permission in the granted scope is enough for the required one
+func ScopeCovers(granted, required string) bool {
+	return granted == values.ScopeAny || (granted != "" && granted == required)
+}
+
+// String formats the permission as resource:action:scope
+func (p Permission) String() string {
+	return p.Resource + ":" + p.Action + ":" + p.Scope
+}
+
+// ParsePermission reads a permission formatted as resource:action:scope, wildcards are
+// allowed for the resource and the action
+func ParsePermission(value string) (Permission, bool) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 3 {
+		return Permission{}, false
+	}
+
+	permission := Permission{Resource: parts[0], Action: parts[1], Scope: parts[2]}
+	return permission, permission.IsValid()
+}
+
+// IsValid tells whether the permission names a known resource, action and scope
+func (p Permission) IsValid() bool {
+	if p.Scope != values.ScopeOwn && p.Scope != values.ScopeAny {
+		return false
+	}
+	if p.Resource == values.PermissionWildcard {
+		return p.Action == values.PermissionWildcard || isPermissionAction(p.Action)
+	}
+
+	actions, ok := permissionActions[p.Resource]
+	if !ok {
+		return false
+	}
+	if p.Action == values.PermissionWildcard {
+		return true
+	}
+	for _, action := range actions {
+		if action == p.Action {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Permission) covers(resource, action string) bool {
+	return (p.Resource == values.PermissionWildcard || p.Resource == resource) &&
+		(p.Action == values.PermissionWildcard || p.Action == action)
+}
+
+func isPermissionAction(action string) bool {
+	for _, actions := range permissionActions {
+		for _, known := range actions {
+			if known == action {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// grant gives the actions on the resource in the scope
+func grant(scope, resource string, actions ...string) []Permission {
+	permissions := make([]Permission, len(actions))
+	for i, action := range actions {
+		permissions[i] = Permission{Resource: resource, Action: action, Scope: scope}
+	}
+	return permissions
+}
+
+func concatPermissions(groups ...[]Permission) []Permission {
+	var permissions []Permission
+	for _, group := range groups {
+		permissions = append(permissions, group...)
+	}
+	return permissions
+}
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"testing"
+
+	"ticket-booking-app-backend/pkg/values"
+)
+
+func TestRoleScope(t *testing.T) {
+	custom := &Role{Name: "moderator", Permissions: []Permission{
+		{Resource: values.ResourceEvents, Action: values.ActionReview, Scope: values.ScopeAny},
+		{Resource: values.ResourceEvents, Action: values.ActionRead, Scope: values.ScopeOwn},
+		{Resource: values.ResourceEvents, Action: values.ActionRead, Scope: values.ScopeAny},
+		{Resource: values.ResourceVenues, Action: values.PermissionWildcard, Scope: values.ScopeOwn},
+	}}
+	user, _ := BuiltInRole(values.UserRole)
+	organizer, _ := BuiltInRole(values.OrganizerRole)
+	admin, _ := BuiltInRole(values.AdminRole)
+
+	tests := []struct {
+		name     string
+		role     *Role
+		resource string
+		action   string
+		want     string
+	}{
+		{"user cancels own tickets", user, values.ResourceTickets, values.ActionCancel, values.ScopeOwn},
+		{"user can't create events", user, values.ResourceEvents, values.ActionCreate, ""},
+		{"organizer publishes own events", organizer, values.ResourceEvents, values.ActionPublish, values.ScopeOwn},
+		{"organizer can't review events", organizer, values.ResourceEvents, values.ActionReview, ""},
+		{"organizer can't manage roles", organizer, values.ResourceRoles, values.ActionCreate, ""},
+		{"admin wildcard covers everything", admin, values.ResourceRoles, values.ActionDelete, values.ScopeAny},
+		{"widest of several grants", custom, values.ResourceEvents, values.ActionRead, values.ScopeAny},
+		{"action wildcard", custom, values.ResourceVenues, values.ActionDelete, values.ScopeOwn},
+		{"other action on the resource", custom, values.ResourceEvents, values.ActionUpdate, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.role.Scope(tt.resource, tt.action); got != tt.want {
+				t.Errorf("Scope(%s, %s) = %q, want %q", tt.resource, tt.action, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeCovers(t *testing.T) {
+	tests := []struct {
+		granted  string
+		required string
+		want     bool
+	}{
+		{values.ScopeAny, values.ScopeAny, true},
+		{values.ScopeAny, values.ScopeOwn, true},
+		{values.ScopeOwn, values.ScopeOwn, true},
+		{values.ScopeOwn, values.ScopeAny, false},
+		{"", values.ScopeOwn, false},
+		{"", values.ScopeAny, false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := ScopeCovers(tt.granted, tt.required); got != tt.want {
+			t.Errorf("ScopeCovers(%q, %q) = %t, want %t", tt.granted, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   Permission
+		wantOk bool
+	}{
+		{"events:read:any", Permission{Resource: values.ResourceEvents, Action: values.ActionRead, Scope: values.ScopeAny}, true},
+		{" venues:delete:own ", Permission{Resource: values.ResourceVenues, Action: values.ActionDelete, Scope: values.ScopeOwn}, true},
+		{"events:*:own", Permission{Resource: values.ResourceEvents, Action: values.PermissionWildcard, Scope: values.ScopeOwn}, true},
+		{"*:read:any", Permission{Resource: values.PermissionWildcard, Action: values.ActionRead, Scope: values.ScopeAny}, true},
+		{"*:*:any", Permission{Resource: values.PermissionWildcard, Action: values.PermissionWildcard, Scope: values.ScopeAny}, true},
+		{"*:fly:any", Permission{}, false},
+		{"events:read:all", Permission{}, false},
+		{"events:read:*", Permission{}, false},
+		{"planets:read:any", Permission{}, false},
+		{"payments:delete:any", Permission{}, false},
+		{"events:read", Permission{}, false},
+		{"events:read:any:more", Permission{}, false},
+		{"", Permission{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePermission(tt.value)
+		if ok != tt.wantOk {
+			t.Errorf("ParsePermission(%q) ok = %t, want %t", tt.value, ok, tt.wantOk)
+			continue
+		}
+		if ok && got != tt.want {
+			t.Errorf("ParsePermission(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+	}
+}
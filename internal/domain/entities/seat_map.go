@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+)
+
+// SeatMap is the layout of a hall, made of sections, rows and seats.
+type SeatMap struct {
+	ID          string         `json:"id"`
+	OrganizerID string         `json:"organizer_id"`
+	VenueID     string         `json:"venue_id,omitempty"`
+	Name        string         `json:"name"`
+	SeatsCount  int            `json:"seats_count"`
+	Sections    []*SeatSection `json:"sections,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type SeatSection struct {
+	ID   string     `json:"id"`
+	Name string     `json:"name"`
+	Rows []*SeatRow `json:"rows"`
+}
+
+type SeatRow struct {
+	ID    string  `json:"id"`
+	Label string  `json:"label"`
+	Seats []*Seat `json:"seats"`
+}
+
+type Seat struct {
+	ID        string `json:"id"`
+	Number    string `json:"number"`
+	Available *bool  `json:"available,omitempty"` // Only set when the map is shown for an event
+}
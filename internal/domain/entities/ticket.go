@@ -6,10 +6,17 @@ import (
 
 // Ticket represents a ticket for an event.
 type Ticket struct {
-	ID         string     `json:"id"`
-	Status     string     `json:"status"` // Status: 'reserved', 'paid', 'cancelled', 'expired'
-	ReservedAt time.Time  `json:"reserved_at"`
-	PaidAt     time.Time `json:"paid_at"`
-	Price      float64    `json:"price"`
-	CreatedAt  time.Time  `json:"created_at"`
+	ID           string    `json:"id"`
+	EventID      string    `json:"event_id"`
+	UserID       string    `json:"user_id"`
+	TicketTypeID string    `json:"ticket_type_id,omitempty"`
+	SeatID       string    `json:"seat_id,omitempty"`
+	PaymentID    string    `json:"payment_id,omitempty"`
+	Status       string    `json:"status"` // Status: 'reserved', 'paid', 'cancelled', 'expired'
+	ReservedAt   time.Time `json:"reserved_at"`
+	PaidAt       time.Time `json:"paid_at"`
+	Price        float64   `json:"price"` // Price at the time of the reservation
+	CreatedAt    time.Time `json:"created_at"`
+
+	RefundPending bool `json:"refund_pending,omitempty"` // The event was cancelled and the refund is still to go through
 }
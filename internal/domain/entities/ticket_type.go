@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+)
+
+// TicketType is a tier of tickets sold for an event, e.g. General, VIP or Early Bird.
+type TicketType struct {
+	ID           string     `json:"id"`
+	EventID      string     `json:"event_id"`
+	Name         string     `json:"name"`
+	Price        float64    `json:"price"`
+	Capacity     int        `json:"capacity"`
+	TicketsSold  int        `json:"tickets_sold"`
+	SalesStartAt *time.Time `json:"sales_start_at,omitempty"` // Sales are open right away when empty
+	SalesEndAt   *time.Time `json:"sales_end_at,omitempty"`   // Sales run until the event when empty
+	MaxPerUser   int        `json:"max_per_user"`             // 0 means only the global per-user limit applies
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TicketSelection is a number of tickets of one type picked for a reservation.
+// An empty TicketTypeID stands for the event's base price. Events with reserved
+// seating need one seat per ticket.
+type TicketSelection struct {
+	TicketTypeID string
+	Quantity     int
+	SeatIDs      []string
+}
@@ -5,12 +5,17 @@ import (
 )
 
 type User struct {
-	ID        string    `json:"id"`
-	Role      string    `json:"role"`
-	Name      string    `json:"name"`
-	Address   string    `json:"address"`
-	Email     string    `json:"email"`
-	Phone     string    `json:"phone"`
-	Password  string    `json:"password"`
-	CreatedAt time.Time `json:"registeredAt"`
+	ID           string    `json:"id"`
+	Role         string    `json:"role"`
+	Name         string    `json:"name"`
+	Address      string    `json:"address"`
+	Email        string    `json:"email"`
+	Phone        string    `json:"phone"`
+	Password     string    `json:"-"`                       // Hash of the password, never sent to clients
+	Status       string    `json:"status"`                  // Organizers start pending until an admin approves them
+	StatusReason string    `json:"status_reason,omitempty"` // Why an organizer was rejected or suspended
+	TokenVersion int       `json:"-"`                       // Access tokens carrying an older version were revoked
+	CreatedAt    time.Time `json:"registeredAt"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"` // Unset until the user confirms their email address
 }
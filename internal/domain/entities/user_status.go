@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// organizerTransitions lists the statuses an organizer account can move to from each status.
+// Approving works from every status but active, so suspended and rejected organizers can be reinstated.
+var organizerTransitions = map[string][]string{
+	values.UserStatusPending:   {values.UserStatusActive, values.UserStatusRejected},
+	values.UserStatusActive:    {values.UserStatusSuspended},
+	values.UserStatusSuspended: {values.UserStatusActive},
+	values.UserStatusRejected:  {values.UserStatusActive},
+}
+
+// CanTransitionOrganizer tells whether an organizer account may move from one status to another
+func CanTransitionOrganizer(from, to string) bool {
+	for _, status := range organizerTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"time"
+)
+
+// Venue is a place where events are held.
+type Venue struct {
+	ID              string    `json:"id"`
+	OrganizerID     string    `json:"organizer_id"` // Who manages the venue
+	Name            string    `json:"name"`
+	Address         string    `json:"address"`
+	City            string    `json:"city"`
+	Country         string    `json:"country"`
+	Latitude        *float64  `json:"latitude,omitempty"`
+	Longitude       *float64  `json:"longitude,omitempty"`
+	Timezone        string    `json:"timezone"`         // IANA name, e.g. Asia/Almaty
+	DefaultCapacity int       `json:"default_capacity"` // Upper bound for the capacity of its events
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+// domain/repository/categories.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type CategoriesRepository interface {
+	// Create operations
+	CreateCategory(ctx context.Context, category *entities.Category) error
+
+	// Read operations
+	GetCategoryByID(ctx context.Context, categoryID string) (*entities.Category, error)
+	GetCategories(ctx context.Context) ([]*entities.Category, error)
+
+	// Update operations
+	UpdateCategory(ctx context.Context, category *entities.Category) error
+
+	// Delete operations
+	DeleteCategory(ctx context.Context, categoryID string) error
+}
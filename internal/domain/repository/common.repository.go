@@ -7,9 +7,18 @@ import (
 type CommonRepository interface {
 	CheckIfUserExistsByEmail(ctx context.Context, email string) error
 	CheckIfUserExistsByIdAndRole(ctx context.Context, userId, role string) error
+	// CheckIfOrganizerIsApproved checks that the account selling tickets is active, organizers
+	// are until an admin approves them
+	CheckIfOrganizerIsApproved(ctx context.Context, organizerID string) error
+	// CheckIfUserIsVerified checks that the user confirmed their email address
+	CheckIfUserIsVerified(ctx context.Context, userID string) error
 	CheckIfEventIsActive(ctx context.Context, eventID string) error
 	CheckIfEventExists(ctx context.Context, eventID string) error
-	CheckIfEventBelongsToOrganizer(ctx context.Context, eventID, organizerID string) error
+	CheckIfCategoryExists(ctx context.Context, categoryID string) error
+	// CheckEventPermission checks that the user's role in the event's organization grants the permission,
+	// events outside any organization are only open to their organizer
+	CheckEventPermission(ctx context.Context, eventID, userID, permission string) error
+	CheckOrganizationPermission(ctx context.Context, organizationID, userID, permission string) error
 	CheckEventAvailableCapacity(ctx context.Context, eventID string) (int, error)
 	CheckIfUserExceededCapacityForEvent(ctx context.Context, eventID, userID string, ticketCount int) error
 }
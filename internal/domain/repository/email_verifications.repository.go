@@ -0,0 +1,25 @@
+// domain/repository/email_verifications.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+// EmailVerificationsRepository stores the pending email verifications, one per user
+type EmailVerificationsRepository interface {
+	// Create operations
+	// SaveVerification stores the user's code, replacing the one sent before
+	SaveVerification(ctx context.Context, verification *entities.EmailVerification) error
+
+	// Read operations
+	// GetVerification fails with ErrEmailAlreadyVerified when the user has no pending verification
+	GetVerification(ctx context.Context, userID string) (*entities.EmailVerification, error)
+
+	// Delete operations
+	// VerifyEmail ends the verification when the code matches. A wrong code counts as an
+	// attempt. Wrong, expired and exhausted codes and users with nothing to verify all fail
+	// with ErrVerificationCodeInvalid, so the answer doesn't tell whether the account exists
+	VerifyEmail(ctx context.Context, userID, codeHash string) error
+}
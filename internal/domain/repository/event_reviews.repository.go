@@ -0,0 +1,19 @@
+// domain/repository/event_reviews.repository.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type EventReviewsRepository interface {
+	// Create operations
+	// SaveReview records the review and moves its event from fromStatus to status in one go,
+	// it fails when the event isn't in fromStatus anymore
+	SaveReview(ctx context.Context, review *entities.EventReview, fromStatus, status string, publishAt *time.Time) error
+
+	// Read operations
+	GetEventReviews(ctx context.Context, eventID string) ([]*entities.EventReview, error)
+}
@@ -0,0 +1,37 @@
+// domain/repository/event_series.repository.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type EventSeriesRepository interface {
+	// Create operations
+	CreateSeries(ctx context.Context, series *entities.EventSeries) error
+	// MaterializeOccurrences creates the occurrences starting at the given times that don't exist yet
+	// and returns how many were created
+	MaterializeOccurrences(ctx context.Context, seriesID string, startTimes []time.Time) (int64, error)
+
+	// Read operations
+	GetSeriesByID(ctx context.Context, seriesID string) (*entities.EventSeries, error)
+	GetSeriesByOrganizer(ctx context.Context, organizerID string) ([]*entities.EventSeries, error)
+	GetAllSeries(ctx context.Context) ([]*entities.EventSeries, error)
+	GetUpcomingOccurrences(ctx context.Context, seriesID string) ([]*entities.Event, error)
+
+	// Update operations
+	// UpdateSeries saves the series and carries the changes over to its upcoming occurrences.
+	// Upcoming occurrences move to the start time of the same instance, day or position in the rule,
+	// the ones left without a start time are removed unless tickets were sold for them.
+	// With a review, a live series and its live occurrences whose title, description, location or dates
+	// change go back to pending_review, and the review is recorded for each of those occurrences.
+	UpdateSeries(ctx context.Context, series *entities.EventSeries, startTimes []time.Time, review *entities.EventReview) error
+	// PublishSeries publishes the series and its upcoming draft and pending review occurrences
+	PublishSeries(ctx context.Context, seriesID string) error
+
+	// Validation operations
+	// ValidateSeriesPermission checks that the user's role in the series' organization grants the permission
+	ValidateSeriesPermission(ctx context.Context, seriesID, userID, permission string) error
+}
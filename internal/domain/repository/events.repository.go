@@ -3,6 +3,8 @@ package repository
 
 import (
     "context"
+    "time"
+
     "ticket-booking-app-backend/internal/domain/entities"
 )
 
@@ -12,21 +14,34 @@ type EventsRepository interface {
     
     // Read operations
     GetEventByID(ctx context.Context, eventID string) (*entities.Event, error)
-    GetEventsByOrganizer(ctx context.Context, status string, organizerID string) ([]*entities.Event, error)
-    GetEvents(ctx context.Context, status string) ([]*entities.Event, error)
+    // GetEvents returns one page of events matching the filter and the total number of matches
+    GetEvents(ctx context.Context, filter *entities.EventFilter) ([]*entities.Event, int64, error)
+    GetEventFacets(ctx context.Context, filter *entities.EventFilter) (*entities.EventFacets, error)
+    // GetNearbyEvents returns one page of events whose venue lies within radiusKm of the point, closest first
+    GetNearbyEvents(ctx context.Context, lat, lng, radiusKm float64, filter *entities.EventFilter) ([]*entities.NearbyEvent, int64, error)
     
     // Update operations
+    // UpdateEvent, UpdateEventStatus, CancelEvent and DeleteEvent only touch events the organizer may manage,
+    // an empty organizerID is for callers allowed on any event
     UpdateEvent(ctx context.Context, organizerID string, event *entities.Event) error
-    UpdateEventStatus(ctx context.Context, eventID, organizerID, status string) error
+    // UpdateEventStatus and UpdateEventPublishing only apply while the event is still in fromStatus,
+    // the status the caller validated the transition from, and fail with ErrInvalidEventTransition otherwise
+    UpdateEventStatus(ctx context.Context, eventID, organizerID, fromStatus, status string) error
+    UpdateEventPublishing(ctx context.Context, eventID, fromStatus, status string, publishAt *time.Time) error
+    // CancelEvent cancels the event if it's still in status, releasing its reservations and
+    // marking its paid tickets for a refund with it
+    CancelEvent(ctx context.Context, eventID, organizerID, status string) error
     UpdateEventCapacity(ctx context.Context, eventID string, capacity int) error
     IncrementTicketsSold(ctx context.Context, eventID string) error
     
     // Status management
-    UpdateExpiredEvents(ctx context.Context) error
+    UpdateEventStatuses(ctx context.Context) error
+    PublishScheduledEvents(ctx context.Context) (int64, error)
+    RecalculateTicketsSold(ctx context.Context) (int64, error)
     
     // Capacity checks
     CheckEventCapacityIsFull(ctx context.Context, eventID string) (bool, error)
     
-    // Delete operations (soft delete via status update)
+    // Delete operations (soft delete via status update), refused while the event has reserved or paid tickets
     DeleteEvent(ctx context.Context, eventID, organizerID string) error
 }
\ No newline at end of file
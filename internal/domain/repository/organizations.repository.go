@@ -0,0 +1,35 @@
+// domain/repository/organizations.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type OrganizationsRepository interface {
+	// Create operations
+	// CreateOrganization creates the organization with its owner as the first member
+	CreateOrganization(ctx context.Context, organization *entities.Organization) error
+	CreateInvitation(ctx context.Context, invitation *entities.OrganizationInvitation) error
+	// AcceptInvitation adds the user to the organization with the invited role, an invitation
+	// is accepted once and only before it expires
+	AcceptInvitation(ctx context.Context, invitationID, userID string) error
+
+	// Read operations
+	// GetOrganizationByID returns the organization with its members
+	GetOrganizationByID(ctx context.Context, organizationID string) (*entities.Organization, error)
+	// GetOrganizationsByUser returns the organizations the user is a member of, with their role
+	GetOrganizationsByUser(ctx context.Context, userID string) ([]*entities.Organization, error)
+	// GetDefaultOrganization returns the first organization the user owns
+	GetDefaultOrganization(ctx context.Context, userID string) (*entities.Organization, error)
+	GetMember(ctx context.Context, organizationID, userID string) (*entities.OrganizationMember, error)
+	GetInvitationByToken(ctx context.Context, tokenHash string) (*entities.OrganizationInvitation, error)
+	GetPendingInvitations(ctx context.Context, organizationID string) ([]*entities.OrganizationInvitation, error)
+
+	// Update operations
+	UpdateMemberRole(ctx context.Context, organizationID, userID, role string) error
+
+	// Delete operations
+	RemoveMember(ctx context.Context, organizationID, userID string) error
+}
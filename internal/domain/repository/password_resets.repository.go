@@ -0,0 +1,19 @@
+// domain/repository/password_resets.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+// PasswordResetsRepository stores the password resets users requested
+type PasswordResetsRepository interface {
+	// Create operations
+	CreatePasswordReset(ctx context.Context, reset *entities.PasswordReset) error
+
+	// Update operations
+	// ResetPassword sets the password of the token's user and uses up every reset of theirs.
+	// It returns the user's ID, a used, expired or unknown token fails with ErrPasswordResetTokenInvalid
+	ResetPassword(ctx context.Context, tokenHash, passwordHash string) (string, error)
+}
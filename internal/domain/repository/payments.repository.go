@@ -0,0 +1,43 @@
+// domain/repository/payments.repository.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type PaymentsRepository interface {
+	// Create operations
+	CreatePayment(ctx context.Context, userID string, ticketIDs []string, payment *entities.Payment) error
+
+	// Read operations
+	GetPaymentByID(ctx context.Context, paymentID string) (*entities.Payment, error)
+	GetPaymentsByUser(ctx context.Context, userID, status string) ([]*entities.Payment, error)
+	GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entities.Payment, error)
+
+	// Update operations
+	// AttachProviderPayment records the provider payment of a checkout, once
+	AttachProviderPayment(ctx context.Context, paymentID, providerPaymentID, checkoutURL string) error
+	// The status changes record the webhook event that caused them, if any, in the same
+	// transaction. An event that was recorded before returns ErrWebhookProcessed and
+	// changes nothing.
+	// CompletePayment marks a pending payment completed and its tickets paid. When one of the
+	// tickets was released meanwhile the payment fails instead, and ErrPaymentReleased is
+	// returned, as it is for payments that already failed or were refunded. The event isn't
+	// recorded then, the caller saves it once the charge is given back.
+	CompletePayment(ctx context.Context, paymentID string, paidAt time.Time, event *entities.PaymentWebhookEvent) error
+	// FailPayment fails a pending payment, its tickets can be checked out again
+	FailPayment(ctx context.Context, paymentID string, event *entities.PaymentWebhookEvent) error
+	// RefundPayment applies a refund the provider reports. A refund we asked for completes
+	// and refunds its ticket only. The payment is refunded, along with the tickets still
+	// paid, once the refunded total reaches its amount. Pending payments are left alone.
+	RefundPayment(ctx context.Context, paymentID string, refund *entities.ProviderRefund, event *entities.PaymentWebhookEvent) error
+
+	// Webhook operations
+	SaveWebhookEvent(ctx context.Context, event *entities.PaymentWebhookEvent) error
+
+	// Validation operations
+	ValidatePaymentOwnership(ctx context.Context, paymentID, userID string) error
+}
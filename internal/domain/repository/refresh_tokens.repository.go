@@ -0,0 +1,30 @@
+// domain/repository/refresh_tokens.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+// RefreshTokensRepository stores the hashes of the refresh tokens handed out
+type RefreshTokensRepository interface {
+	// Create operations
+	// CreateRefreshToken stores the first token of a family, the family gets the token's id
+	// when it has none
+	CreateRefreshToken(ctx context.Context, token *entities.RefreshToken) error
+
+	// Read operations
+	// GetRevokedFamilies lists the user's revoked sessions whose tokens haven't expired yet
+	GetRevokedFamilies(ctx context.Context, userID string) ([]string, error)
+
+	// Update operations
+	// RotateRefreshToken marks the token with the hash used and stores the next token of its
+	// family. An unknown, revoked or expired token fails with ErrRefreshTokenInvalid. A token
+	// used before is a replay: the whole family gets revoked and ErrRefreshTokenReused is returned
+	RotateRefreshToken(ctx context.Context, tokenHash string, next *entities.RefreshToken) error
+	// RevokeRefreshTokenFamily ends one session of the user
+	RevokeRefreshTokenFamily(ctx context.Context, userID, familyID string) error
+	// RevokeOtherRefreshTokenFamilies ends every session of the user but the one of the family
+	RevokeOtherRefreshTokenFamilies(ctx context.Context, userID, familyID string) error
+}
@@ -0,0 +1,21 @@
+// domain/repository/refunds.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type RefundsRepository interface {
+	// Create operations
+	CreateRefund(ctx context.Context, refund *entities.Refund) error
+
+	// Read operations
+	GetRefundsByEvent(ctx context.Context, eventID, status string) ([]*entities.Refund, error)
+	GetRefundsByUser(ctx context.Context, userID, status string) ([]*entities.Refund, error)
+
+	// Update operations
+	CompleteRefund(ctx context.Context, refundID, providerRefundID string) error
+	FailRefund(ctx context.Context, refundID, failureReason string) error
+}
@@ -7,17 +7,45 @@ import (
 )
 
 type Repository struct {
-	Common  CommonRepository
-	Users   UsersRepository
-	Events  EventsRepository
-	Tickets TicketsRepository
+	Common        CommonRepository
+	Users         UsersRepository
+	Roles         RolesRepository
+	RefreshTokens RefreshTokensRepository
+	Verifications EmailVerificationsRepository
+	Resets        PasswordResetsRepository
+	Organizations OrganizationsRepository
+	Events        EventsRepository
+	EventSeries   EventSeriesRepository
+	Reviews       EventReviewsRepository
+	Search        SearchRepository
+	Categories    CategoriesRepository
+	TicketTypes   TicketTypesRepository
+	Venues        VenuesRepository
+	SeatMaps      SeatMapsRepository
+	Tickets       TicketsRepository
+	Payments      PaymentsRepository
+	Refunds       RefundsRepository
 }
 
 func NewRepositories(db *gorm.DB) *Repository {
 	return &Repository{
-		Common:  postgres.NewCommonRepository(db),
-		Users:   postgres.NewUsersRepository(db),
-		Events:  postgres.NewEventsRepository(db),
-		Tickets: postgres.NewTicketsRepository(db),
+		Common:        postgres.NewCommonRepository(db),
+		Users:         postgres.NewUsersRepository(db),
+		Roles:         postgres.NewRolesRepository(db),
+		RefreshTokens: postgres.NewRefreshTokensRepository(db),
+		Verifications: postgres.NewEmailVerificationsRepository(db),
+		Resets:        postgres.NewPasswordResetsRepository(db),
+		Organizations: postgres.NewOrganizationsRepository(db),
+		Events:        postgres.NewEventsRepository(db),
+		EventSeries:   postgres.NewEventSeriesRepository(db),
+		Reviews:       postgres.NewEventReviewsRepository(db),
+		Search:        postgres.NewSearchRepository(db),
+		Categories:    postgres.NewCategoriesRepository(db),
+		TicketTypes:   postgres.NewTicketTypesRepository(db),
+		Venues:        postgres.NewVenuesRepository(db),
+		SeatMaps:      postgres.NewSeatMapsRepository(db),
+		Tickets:       postgres.NewTicketsRepository(db),
+		Payments:      postgres.NewPaymentsRepository(db),
+		Refunds:       postgres.NewRefundsRepository(db),
 	}
 }
@@ -0,0 +1,26 @@
+// domain/repository/roles.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+// RolesRepository stores the custom roles, the built-in ones live in code
+type RolesRepository interface {
+	// Create operations
+	CreateRole(ctx context.Context, role *entities.Role) error
+
+	// Read operations
+	GetRoles(ctx context.Context) ([]*entities.Role, error)
+	GetRoleByName(ctx context.Context, name string) (*entities.Role, error)
+
+	// Update operations
+	// UpdateRole replaces the description and the permissions of the role
+	UpdateRole(ctx context.Context, role *entities.Role) error
+
+	// Delete operations
+	// DeleteRole removes a role no user holds anymore
+	DeleteRole(ctx context.Context, name string) error
+}
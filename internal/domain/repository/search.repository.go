@@ -0,0 +1,16 @@
+// domain/repository/search.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type SearchRepository interface {
+	// Read operations
+
+	// SearchEvents returns one page of events matching filter.Search and the other filters,
+	// best matches first unless the filter asks for another order, and the total number of matches
+	SearchEvents(ctx context.Context, filter *entities.EventFilter) ([]*entities.EventSearchHit, int64, error)
+}
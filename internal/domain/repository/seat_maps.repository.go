@@ -0,0 +1,24 @@
+// domain/repository/seat_maps.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type SeatMapsRepository interface {
+	// Create operations
+	CreateSeatMap(ctx context.Context, seatMap *entities.SeatMap) error
+
+	// Read operations
+	GetSeatMapByID(ctx context.Context, seatMapID string) (*entities.SeatMap, error)
+	GetSeatMapsByOrganizer(ctx context.Context, organizerID string) ([]*entities.SeatMap, error)
+	GetEventSeats(ctx context.Context, eventID string) (*entities.SeatMap, error)
+
+	// Update operations
+	AttachSeatMapToEvent(ctx context.Context, eventID, seatMapID string) error
+
+	// Validation operations
+	ValidateSeatMapOwnership(ctx context.Context, seatMapID, organizerID string) error
+}
@@ -0,0 +1,23 @@
+// domain/repository/ticket_types.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type TicketTypesRepository interface {
+	// Create operations
+	CreateTicketType(ctx context.Context, ticketType *entities.TicketType) error
+
+	// Read operations
+	GetTicketTypeByID(ctx context.Context, ticketTypeID string) (*entities.TicketType, error)
+	GetTicketTypesByEvent(ctx context.Context, eventID string) ([]*entities.TicketType, error)
+
+	// Update operations
+	UpdateTicketType(ctx context.Context, ticketType *entities.TicketType) error
+
+	// Delete operations
+	DeleteTicketType(ctx context.Context, ticketTypeID string) error
+}
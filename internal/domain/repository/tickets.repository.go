@@ -10,20 +10,23 @@ import (
 type TicketsRepository interface {
 	// Create operations
 	CreateTicket(ctx context.Context, eventID, userID string, ticket *entities.Ticket) error
-	CreateTickets(ctx context.Context, eventID, userID string, count int) ([]*entities.Ticket, error)
+	CreateTickets(ctx context.Context, eventID, userID string, selections []entities.TicketSelection) ([]*entities.Ticket, error)
 
 	// Read operations
 	GetTicketByID(ctx context.Context, ticketID string) (*entities.Ticket, error)
 	GetTicketsByEvent(ctx context.Context, eventID, status string) ([]*entities.Ticket, error)
 	GetTicketsByUser(ctx context.Context, userID, status string) ([]*entities.Ticket, error)
 	GetTicketWithEvent(ctx context.Context, ticketID string) (*entities.Ticket, error)
+	GetRefundPendingTickets(ctx context.Context, eventID string) ([]*entities.Ticket, error)
 
 	// Update operations
 	UpdateTicketStatus(ctx context.Context, ticketID string, status string) error
 	UpdateTicketPayment(ctx context.Context, ticketID string, paidAt time.Time) error
+	CancelTicket(ctx context.Context, ticketID string) error
 
 	// Batch operations
-	UpdateExpiredTickets(ctx context.Context) error
+	UpdateExpiredTickets(ctx context.Context) (int64, error)
+	// CancelEventTickets cancels the event's reservations and marks its paid tickets for a refund
 	CancelEventTickets(ctx context.Context, eventID string) error
 
 	// Validation operations
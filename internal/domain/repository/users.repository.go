@@ -9,5 +9,18 @@ import (
 type UsersRepository interface {
 	Create(ctx context.Context, organizationId string, user *entities.User) error
 	GetByEmail(ctx context.Context, email string) (*entities.User, error)
-	// Update(ctx context.Context, user domain.User) error
+	GetByID(ctx context.Context, userID string) (*entities.User, error)
+	// GetUsers lists the users with the role, an empty status lists all of them
+	GetUsers(ctx context.Context, role, status string) ([]*entities.User, error)
+	// UpdateOrganizerStatus changes the status of an organizer, suspending one stops the sales
+	// of their events and cancels the unpaid reservations
+	UpdateOrganizerStatus(ctx context.Context, organizerID, status, reason string) error
+	// UpdateRole gives the user another built-in or custom role
+	UpdateRole(ctx context.Context, userID, role string) error
+	// RevokeSessions bumps the token version of the user and revokes their refresh tokens,
+	// every session of the user ends
+	RevokeSessions(ctx context.Context, userID string) error
+	// Update saves the profile of the user, their name, address and phone
+	Update(ctx context.Context, user *entities.User) error
+	UpdatePassword(ctx context.Context, userID, passwordHash string) error
 }
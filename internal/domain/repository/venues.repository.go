@@ -0,0 +1,26 @@
+// domain/repository/venues.repository.go
+package repository
+
+import (
+	"context"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+)
+
+type VenuesRepository interface {
+	// Create operations
+	CreateVenue(ctx context.Context, venue *entities.Venue) error
+
+	// Read operations
+	GetVenueByID(ctx context.Context, venueID string) (*entities.Venue, error)
+	GetVenues(ctx context.Context, city, country string) ([]*entities.Venue, error)
+
+	// Update operations
+	UpdateVenue(ctx context.Context, venue *entities.Venue) error
+
+	// Delete operations
+	DeleteVenue(ctx context.Context, venueID string) error
+
+	// Validation operations
+	ValidateVenueOwnership(ctx context.Context, venueID, organizerID string) error
+}
@@ -1,6 +1,10 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+
+	"ticket-booking-app-backend/pkg/recurrence"
+)
 
 var (
 	ErrUserNotFound          = errors.New("user doesn't exists")
@@ -8,10 +12,51 @@ var (
 	ErrUserPasswordIncorrect = errors.New("password incorrect")
 )
 
+var (
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used, sign in again")
+	ErrSessionRevoked      = errors.New("session was signed out, sign in again")
+)
+
+var (
+	ErrEmailNotVerified        = errors.New("email address isn't verified yet")
+	ErrEmailAlreadyVerified    = errors.New("email address is already verified")
+	ErrVerificationCodeInvalid = errors.New("verification code is invalid or expired, request a new one")
+)
+
+var (
+	ErrPasswordResetTokenInvalid = errors.New("password reset token is invalid or expired")
+)
+
 var (
 	ErrAdminNotFound           = errors.New("admin doesn't exists")
 	ErrInsufficientPermissions = errors.New("insufficient permissions")
 	ErrOrganizerNotFound       = errors.New("organizer doesn't exists")
+
+	ErrOrganizerNotApproved       = errors.New("organizer account is not approved")
+	ErrOrganizerRejected          = errors.New("organizer account was rejected")
+	ErrOrganizerSuspended         = errors.New("organizer account is suspended")
+	ErrInvalidOrganizerTransition = errors.New("organizer account can't move to this status from its current one")
+)
+
+var (
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	ErrOrganizationMemberExists   = errors.New("user is already a member of the organization")
+	ErrOrganizationOwnerRequired  = errors.New("the owner of an organization can't leave it or change role")
+	ErrInvalidOrganizationRole    = errors.New("invalid organization role")
+	ErrInvitationNotFound         = errors.New("invitation not found")
+	ErrInvitationExpired          = errors.New("invitation expired or was already accepted")
+	ErrInvitationEmailMismatch    = errors.New("invitation was sent to another email")
+)
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleExists        = errors.New("role with this name already exists")
+	ErrRoleInUse         = errors.New("role is assigned to users")
+	ErrBuiltInRole       = errors.New("built-in roles can't be changed")
+	ErrInvalidRoleName   = errors.New("role name must be lowercase letters, digits and underscores")
+	ErrInvalidPermission = errors.New("invalid permission, expected resource:action:scope")
 )
 
 var (
@@ -19,14 +64,72 @@ var (
 	ErrEventAlreadyFinished    = errors.New("event already finished")
 	ErrEventAlreadyCancelled   = errors.New("event already cancelled")
 	ErrEventCapacityExceeded   = errors.New("event capacity exceeded")
+	ErrEventCapacityBelowSold  = errors.New("event capacity is below the tickets already sold")
 	ErrEventDateInvalid        = errors.New("event date must be in the future")
 	ErrUnauthorizedEventAccess = errors.New("unauthorized access to event")
 	ErrInvalidEventStatus      = errors.New("invalid event status")
+	ErrInvalidEventFilter      = errors.New("invalid event filter, range start is after its end")
+	ErrInvalidEventTransition  = errors.New("event can't move to this status from its current one")
+	ErrEventNotPendingReview   = errors.New("event is not waiting for review")
+	ErrEventHasTickets         = errors.New("event has reserved or paid tickets, cancel it instead")
+)
+
+var (
+	ErrEventSeriesNotFound = errors.New("event series not found")
+	ErrInvalidRecurrence   = recurrence.ErrInvalidRule
 )
 
 var (
 	ErrEventNotActive      = errors.New("event is not active")
+	ErrEventSalesClosed    = errors.New("ticket sales for this event are closed")
 	ErrInsufficientTickets = errors.New("insufficient tickets")
 	ErrInvalidTicketStatus = errors.New("invalid ticket status")
 	ErrTicketNotFound      = errors.New("ticket not found")
+	ErrTicketLimitExceeded = errors.New("ticket limit exceeded")
+)
+
+var (
+	ErrTicketTypeNotFound         = errors.New("ticket type not found")
+	ErrTicketTypeNotOnSale        = errors.New("ticket type is not on sale")
+	ErrTicketTypeRequired         = errors.New("ticket type is required for this event")
+	ErrTicketTypeInUse            = errors.New("ticket type already has tickets")
+	ErrTicketTypeCapacityExceeded = errors.New("ticket type capacities exceed event capacity")
+	ErrTicketTypeSalesWindow      = errors.New("ticket type sales must end after they start")
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryExists      = errors.New("category with this name already exists")
+	ErrCategoryInUse       = errors.New("category is assigned to events")
+	ErrInvalidCategoryName = errors.New("category name must contain letters or digits")
+)
+
+var (
+	ErrVenueNotFound         = errors.New("venue not found")
+	ErrVenueInUse            = errors.New("venue is used by events")
+	ErrInvalidTimezone       = errors.New("invalid timezone")
+	ErrVenueCapacityExceeded = errors.New("event capacity exceeds venue capacity")
+)
+
+var (
+	ErrSeatMapNotFound      = errors.New("seat map not found")
+	ErrSeatNotAvailable     = errors.New("seat is not available")
+	ErrInvalidSeatSelection = errors.New("seat selection doesn't match the event seating")
+	ErrSeatMapLocked        = errors.New("seat map can't be changed once tickets are sold")
+	ErrSeatMapVenueMismatch = errors.New("seat map belongs to another venue")
+)
+
+var (
+	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrInvalidPaymentStatus = errors.New("invalid payment status")
+	ErrTicketNotPayable     = errors.New("ticket cannot be paid")
+	ErrInvalidWebhook       = errors.New("invalid payment webhook")
+	ErrPaymentReleased      = errors.New("payment tickets were released before it completed")
+	ErrWebhookProcessed     = errors.New("payment webhook event was already processed")
+)
+
+var (
+	ErrRefundNotFound         = errors.New("refund not found")
+	ErrRefundNotAllowed       = errors.New("refunds are not allowed for this ticket")
+	ErrRefundAlreadyRequested = errors.New("refund already requested for this ticket")
 )
@@ -1,7 +1,11 @@
 package helpers
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 	"os"
 
 	"golang.org/x/crypto/bcrypt"
@@ -20,3 +24,31 @@ func CheckPasswordHash(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(fmt.Sprintf("%s;%s", PASSWORD_SALT, password)))
 	return err == nil
 }
+
+// NewToken generates a random token for links sent by email
+func NewToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// NewCode generates a random code of digits, short enough to be typed in
+func NewCode(length int) (string, error) {
+	code := make([]byte, length)
+	for i := range code {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		code[i] = byte('0' + digit.Int64())
+	}
+	return string(code), nil
+}
+
+// HashToken hashes a token for storage, tokens are random so a plain SHA-256 is enough
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
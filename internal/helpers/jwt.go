@@ -19,40 +19,67 @@ const (
 
 type Jwt interface {
 	CreateAccessToken(claims UserAccessTokenClaims) (*Token, error)
+	CreateRefreshToken(claims UserRefreshTokenClaims) (*Token, error)
 	Verify(accessToken string) (*tokenClaims, error)
+	VerifyRefreshToken(refreshToken string) (*tokenClaims, error)
 }
 
 type jwtStructure struct {
 	userAccessTokenSecret       string
 	accessTokenLiftimeMinutes   int
+	refreshTokenSecret          string
+	refreshTokenLifetimeMinutes int
 }
 
 type Token struct {
 	AccessToken           string
 	AccessTokenExpiresAt  int64
+	RefreshToken          string
+	RefreshTokenExpiresAt int64
 }
 
 type tokenClaims struct {
 	jwt.StandardClaims
-	UserId string `json:"user_id"`
-	Role   string `json:"role"`
+	UserId       string `json:"user_id"`
+	Role         string `json:"role"`
+	SessionId    string `json:"session_id"`
+	TokenVersion int    `json:"token_version"`
 }
 
 type UserAccessTokenClaims struct {
+	UserId       string `json:"user_id"`
+	Role         string `json:"role"`
+	SessionId    string `json:"session_id"`    // Family of the refresh token handed out with it
+	TokenVersion int    `json:"token_version"` // Version of the user's tokens it was issued at
+}
+
+type UserRefreshTokenClaims struct {
 	UserId string `json:"user_id"`
-	Role   string `json:"role"`
 }
 
-func NewJwt() (*jwtStructure, error) {
+// NewJwt reads the secrets and lifetimes from the environment, the refresh token lifetime
+// falls back to refreshTokenTTL when it isn't set there
+func NewJwt(refreshTokenTTL time.Duration) (*jwtStructure, error) {
 	accessTokenLifetimeMinutes, err := strconv.Atoi(os.Getenv(accessTokenLifetimeMinutesKey))
 	if err != nil {
 		logrus.Fatalf("Error parsing access token lifetime minutes: %s", err)
 		return nil, err
 	}
 
+	refreshTokenLifetimeMinutes := int(refreshTokenTTL / time.Minute)
+	if value := os.Getenv(refreshTokenLifetimeMinutesKey); value != "" {
+		refreshTokenLifetimeMinutes, err = strconv.Atoi(value)
+		if err != nil {
+			logrus.Fatalf("Error parsing refresh token lifetime minutes: %s", err)
+			return nil, err
+		}
+	}
+
 	return &jwtStructure{
 		userAccessTokenSecret:       os.Getenv(userAccessTokenSecretKey),
 		accessTokenLiftimeMinutes:   accessTokenLifetimeMinutes,
+		refreshTokenSecret:          os.Getenv(refreshTokenSecretKey),
+		refreshTokenLifetimeMinutes: refreshTokenLifetimeMinutes,
 	}, nil
 }
 
@@ -60,9 +87,11 @@ func (j *jwtStructure) CreateAccessToken(claims UserAccessTokenClaims) (*Token,
 	var res Token
 	expirationTime := time.Now().Add(time.Duration(j.accessTokenLiftimeMinutes) * time.Minute).Unix()
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": claims.UserId,
-		"role":    claims.Role,
-		"exp":     expirationTime,
+		"user_id":       claims.UserId,
+		"role":          claims.Role,
+		"session_id":    claims.SessionId,
+		"token_version": claims.TokenVersion,
+		"exp":           expirationTime,
 	})
 	tokenString, err := token.SignedString([]byte(j.userAccessTokenSecret))
 	if err != nil {
@@ -75,9 +104,45 @@ func (j *jwtStructure) CreateAccessToken(claims UserAccessTokenClaims) (*Token,
 	return &res, nil
 }
 
+// CreateRefreshToken signs a refresh token, every one of them carries a random id so no two are alike
+func (j *jwtStructure) CreateRefreshToken(claims UserRefreshTokenClaims) (*Token, error) {
+	var res Token
+	tokenID, err := NewToken()
+	if err != nil {
+		logrus.Errorf("Error generating refresh token id: %s", err)
+		return nil, err
+	}
+
+	expirationTime := time.Now().Add(time.Duration(j.refreshTokenLifetimeMinutes) * time.Minute).Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": claims.UserId,
+		"jti":     tokenID,
+		"exp":     expirationTime,
+	})
+	tokenString, err := token.SignedString([]byte(j.refreshTokenSecret))
+	if err != nil {
+		logrus.Errorf("Error signing: %s", err)
+		return nil, err
+	}
+	res.RefreshToken = tokenString
+	res.RefreshTokenExpiresAt = expirationTime
+
+	return &res, nil
+}
+
 func (j *jwtStructure) Verify(accessToken string) (*tokenClaims, error) {
+	return j.verify(accessToken, j.userAccessTokenSecret)
+}
+
+// VerifyRefreshToken only checks the signature and expiry, whether the token was
+// revoked or already used is known to the server alone
+func (j *jwtStructure) VerifyRefreshToken(refreshToken string) (*tokenClaims, error) {
+	return j.verify(refreshToken, j.refreshTokenSecret)
+}
+
+func (j *jwtStructure) verify(signedToken, secret string) (*tokenClaims, error) {
 	token, err := jwt.ParseWithClaims(
-		accessToken,
+		signedToken,
 		&tokenClaims{},
 		func(token *jwt.Token) (interface{}, error) {
 			_, ok := token.Method.(*jwt.SigningMethodHMAC)
@@ -85,7 +150,7 @@ func (j *jwtStructure) Verify(accessToken string) (*tokenClaims, error) {
 				return nil, fmt.Errorf("unexpected token signing method")
 			}
 
-			return []byte(j.userAccessTokenSecret), nil
+			return []byte(secret), nil
 		},
 	)
 
@@ -17,9 +17,14 @@ const (
 	defaultLimiterBurst           = 2
 	defaultLimiterTTL             = 10 * time.Minute
 	defaultVerificationCodeLength = 8
+	defaultMailFile               = "mail.log"
+	defaultSMTPPort               = "587"
 
 	EnvLocal = "local"
 	Prod     = "prod"
+
+	// PaymentProviderFake settles payments in memory, production refuses to start with it
+	PaymentProviderFake = "fake"
 )
 
 type (
@@ -27,6 +32,9 @@ type (
 		Environment string
 		HTTP        HTTPConfig
 		Auth        AuthConfig
+		Events      EventsConfig
+		Mail        MailConfig
+		Payments    PaymentsConfig
 	}
 
 
@@ -38,6 +46,27 @@ type (
 		MaxHeaderMegabytes int           `mapstructure:"maxHeaderBytes"`
 	}
 
+	MailConfig struct {
+		From string `mapstructure:"from"`
+		File string `mapstructure:"file"` // Mail is written here when no SMTP host is set
+		SMTP SMTPConfig
+	}
+
+	SMTPConfig struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+	}
+
+	PaymentsConfig struct {
+		Provider string `mapstructure:"provider"`
+	}
+
+	EventsConfig struct {
+		Moderation bool `mapstructure:"moderation"` // Organizers' events need an admin's approval to go live
+	}
+
 	AuthConfig struct {
 		JWT                    JWTConfig
 		PasswordSalt           string
@@ -73,13 +102,45 @@ func Init(configsDir string) (*Config, error) {
 }
 
 func unmarshal(cfg *Config) error {
-	return viper.UnmarshalKey("http", &cfg.HTTP)
+	if err := viper.UnmarshalKey("http", &cfg.HTTP); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("auth", &cfg.Auth); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("mail", &cfg.Mail); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("payments", &cfg.Payments); err != nil {
+		return err
+	}
+
+	return viper.UnmarshalKey("events", &cfg.Events)
 }
 
 func setFromEnv(cfg *Config) {
 	// TODO use envconfig https://github.com/kelseyhightower/envconfig
 	cfg.Environment = os.Getenv("APP_ENV")
 	cfg.Auth.JWT.SigningKey = os.Getenv("JWT_SIGNING_KEY")
+	cfg.Mail.SMTP.Host = os.Getenv("SMTP_HOST")
+	cfg.Mail.SMTP.Port = os.Getenv("SMTP_PORT")
+	if cfg.Mail.SMTP.Port == "" {
+		cfg.Mail.SMTP.Port = defaultSMTPPort
+	}
+	cfg.Mail.SMTP.Username = os.Getenv("SMTP_USERNAME")
+	cfg.Mail.SMTP.Password = os.Getenv("SMTP_PASSWORD")
+	if from := os.Getenv("MAIL_FROM"); from != "" {
+		cfg.Mail.From = from
+	}
+	if provider := os.Getenv("PAYMENT_PROVIDER"); provider != "" {
+		cfg.Payments.Provider = provider
+	}
+	if moderation := os.Getenv("EVENTS_MODERATION"); moderation != "" {
+		cfg.Events.Moderation = moderation == "true"
+	}
 }
 
 func parseConfigFile(folder, env string) error {
@@ -104,4 +165,9 @@ func populateDefaults() {
 	viper.SetDefault("http.max_header_megabytes", defaultHTTPMaxHeaderMegabytes)
 	viper.SetDefault("http.timeouts.read", defaultHTTPRWTimeout)
 	viper.SetDefault("http.timeouts.write", defaultHTTPRWTimeout)
+	viper.SetDefault("auth.jwt.accessTokenTTL", defaultAccessTokenTTL)
+	viper.SetDefault("auth.jwt.refreshTokenTTL", defaultRefreshTokenTTL)
+	viper.SetDefault("auth.verificationCodeLength", defaultVerificationCodeLength)
+	viper.SetDefault("mail.file", defaultMailFile)
+	viper.SetDefault("events.moderation", false)
 }
@@ -22,6 +22,8 @@ const (
 	dbHostKey     = "DB_HOST"
 	dbPortKey     = "DB_PORT"
 	dbNameKey     = "DB_NAME"
+
+	setupDir = "internal/infrastructure/drivers/postgres/setup"
 )
 
 var (
@@ -80,19 +82,8 @@ func NewDatabase() *Database {
 			logrus.Fatalf("failed to connect database: %v", err)
 		}
 
-		if err = execSqlFromFile(db, "internal/infrastructure/drivers/postgres/setup/setup.sql"); err != nil {
-			logrus.Fatalf("failed to execute setup sql file: %v", err)
-		}
-
-		// Auto-migrate the database schema
-		err = db.AutoMigrate(
-			&models.User{},
-			&models.Event{},
-			&models.Ticket{},
-			&models.Payment{},
-		)
-		if err != nil {
-			logrus.Fatalf("failed to auto-migrate database: %v", err)
+		if err = Migrate(db, setupDir); err != nil {
+			logrus.Fatal(err)
 		}
 
 		dbInstance = &Database{Conn: db}
@@ -102,6 +93,51 @@ func NewDatabase() *Database {
 	return dbInstance
 }
 
+// Migrate brings the schema up to date, reading the sql files from dir
+func Migrate(db *gorm.DB, dir string) error {
+	if err := execSqlFromFile(db, filepath.Join(dir, "setup.sql")); err != nil {
+		return fmt.Errorf("failed to execute setup sql file: %w", err)
+	}
+
+	// Auto-migrate the database schema
+	err := db.AutoMigrate(
+		&models.User{},
+		&models.RefreshToken{},
+		&models.EmailVerification{},
+		&models.PasswordReset{},
+		&models.Organization{},
+		&models.OrganizationMember{},
+		&models.OrganizationInvitation{},
+		&models.Role{},
+		&models.RolePermission{},
+		&models.Venue{},
+		&models.Category{},
+		&models.EventSeries{},
+		&models.SeatMap{},
+		&models.SeatSection{},
+		&models.SeatRow{},
+		&models.Seat{},
+		&models.Event{},
+		&models.EventTag{},
+		&models.EventReview{},
+		&models.TicketType{},
+		&models.Ticket{},
+		&models.Payment{},
+		&models.PaymentWebhookEvent{},
+		&models.Refund{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to auto-migrate database: %w", err)
+	}
+
+	// Indexes and constraints gorm can't express, they need the migrated tables
+	if err := execSqlFromFile(db, filepath.Join(dir, "post_migrate.sql")); err != nil {
+		return fmt.Errorf("failed to execute post-migrate sql file: %w", err)
+	}
+
+	return nil
+}
+
 func execSqlFromFile(db *gorm.DB, filePath string) error {
 	absPath, err := filepath.Abs(filePath)
 	if err != nil {
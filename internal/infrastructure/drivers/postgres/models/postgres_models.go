@@ -9,19 +9,24 @@ import (
 
 // User model with UUID primary key.
 type User struct {
-	ID        uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
-	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
-	Email     string         `gorm:"type:varchar(255);not null;unique" json:"email"`
-	Password  string         `gorm:"type:varchar(255);not null" json:"password"`
-	Name      string         `gorm:"type:varchar(100)" json:"name"`
-	Address   string         `gorm:"type:varchar(255)" json:"address"`
-	Phone     string         `gorm:"type:varchar(20)" json:"phone"`
-	Role      string         `gorm:"type:varchar(50);not null;default:'user'" json:"role"` // Roles: 'user', 'organizer'
-	Events    []Event        `gorm:"foreignKey:OrganizerID" json:"events"`
-	Tickets   []Ticket       `gorm:"constraint:OnDelete:SET NULL;" json:"tickets"`
-	Payments  []Payment      `gorm:"constraint:OnDelete:CASCADE;" json:"payments"`
+	ID           uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	Email        string         `gorm:"type:varchar(255);not null;unique" json:"email"`
+	Password     string         `gorm:"type:varchar(255);not null" json:"password"`
+	Name         string         `gorm:"type:varchar(100)" json:"name"`
+	Address      string         `gorm:"type:varchar(255)" json:"address"`
+	Phone        string         `gorm:"type:varchar(20)" json:"phone"`
+	Role         string         `gorm:"type:varchar(50);not null;default:'user'" json:"role"`     // Roles: 'user', 'organizer', 'admin'
+	Status       string         `gorm:"type:varchar(20);not null;default:'active'" json:"status"` // Status: 'active', 'pending', 'rejected', 'suspended'
+	StatusReason string         `gorm:"type:text" json:"status_reason"`                           // Why an organizer was rejected or suspended
+	TokenVersion int            `gorm:"not null;default:0" json:"token_version"`                  // Bumped to revoke every access token of the user
+	Events       []Event        `gorm:"foreignKey:OrganizerID" json:"events"`
+	Tickets      []Ticket       `gorm:"constraint:OnDelete:SET NULL;" json:"tickets"`
+	Payments     []Payment      `gorm:"constraint:OnDelete:CASCADE;" json:"payments"`
+
+	EmailVerifiedAt *time.Time `gorm:"type:timestamptz" json:"email_verified_at"` // Unset until the user confirms their email address
 }
 
 // Event model with UUID primary key.
@@ -34,12 +39,33 @@ type Event struct {
 	Title       string         `gorm:"type:varchar(255);not null" json:"title"`
 	Description string         `gorm:"type:text" json:"description"`
 	Location    string         `gorm:"type:varchar(255)" json:"location"`
-	Date        time.Time      `gorm:"type:timestamptz;not null" json:"date"`
+	VenueID     *uuid.UUID     `gorm:"type:uuid;index" json:"venue_id"`
+	CategoryID  *uuid.UUID     `gorm:"type:uuid;index" json:"category_id"`
+	Tags        []EventTag     `gorm:"constraint:OnDelete:CASCADE;" json:"tags"`
+	StartsAt    time.Time      `gorm:"type:timestamptz;not null;index" json:"starts_at"`
+	EndsAt      time.Time      `gorm:"type:timestamptz;not null;index" json:"ends_at"`
 	Capacity    int            `gorm:"not null" json:"capacity"`
 	TicketsSold int            `gorm:"not null;default:0" json:"tickets_sold"`
 	Price       float64        `gorm:"type:decimal(10,2);not null" json:"price"`
-	Status      string         `gorm:"type:varchar(50);not null;default:'upcoming'" json:"status"` // Status: 'upcoming', 'ongoing', 'completed', 'cancelled'
+	Status      string         `gorm:"type:varchar(50);not null;default:'draft'" json:"status"` // Status: 'draft', 'pending_review', 'scheduled', 'published', 'ongoing', 'finished', 'cancelled'
+	PublishAt   *time.Time     `gorm:"type:timestamptz;index" json:"publish_at"`                // Set while the event is scheduled or its review asks for a publish time
 	Tickets     []Ticket       `gorm:"constraint:OnDelete:CASCADE;" json:"tickets"`
+	TicketTypes []TicketType   `gorm:"constraint:OnDelete:CASCADE;" json:"ticket_types"`
+	SeatMapID   *uuid.UUID     `gorm:"type:uuid;index" json:"seat_map_id"` // Set for events with reserved seating
+	Reviews     []EventReview  `gorm:"constraint:OnDelete:CASCADE;" json:"reviews"`
+
+	// Events belong to an organization, its members manage them according to their role
+	OrganizationID *uuid.UUID `gorm:"type:uuid;index" json:"organization_id"`
+
+	// Occurrences of a series remember which instance of the rule they are, the RECURRENCE-ID,
+	// so moving one of them doesn't make the series create it again
+	SeriesID     *uuid.UUID `gorm:"type:uuid;index" json:"series_id"`
+	RecurrenceAt *time.Time `gorm:"type:timestamptz" json:"recurrence_at"`
+
+	ReservationTTLMinutes int  `gorm:"not null;default:15" json:"reservation_ttl_minutes"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes"`
+	RefundPercent         int  `gorm:"not null;default:0" json:"refund_percent"`
+	RefundDeadlineHours   int  `gorm:"not null;default:0" json:"refund_deadline_hours"`
 }
 
 // Ticket model with UUID primary key.
@@ -54,18 +80,269 @@ type Ticket struct {
 	ReservedAt time.Time      `gorm:"autoCreateTime" json:"reserved_at"`
 	PaidAt     time.Time      `json:"paid_at"`
 	Price      float64        `gorm:"type:decimal(10,2);not null" json:"price"`
+	PaymentID  *uuid.UUID     `gorm:"type:uuid;index" json:"payment_id"` // Set while the ticket is part of a checkout
 	Event      Event          `gorm:"foreignKey:EventID" json:"event"`
+
+	TicketTypeID *uuid.UUID `gorm:"type:uuid;index" json:"ticket_type_id"` // Empty for events sold at a single price
+	SeatID       *uuid.UUID `gorm:"type:uuid;index" json:"seat_id"`        // Set for events with reserved seating
+
+	// Set on the paid tickets of a cancelled event until their refund goes through
+	RefundPending bool `gorm:"not null;default:false;index" json:"refund_pending"`
+}
+
+// TicketType model with UUID primary key.
+type TicketType struct {
+	ID           uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	EventID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	Name         string         `gorm:"type:varchar(100);not null" json:"name"`
+	Price        float64        `gorm:"type:decimal(10,2);not null" json:"price"`
+	Capacity     int            `gorm:"not null" json:"capacity"`
+	TicketsSold  int            `gorm:"not null;default:0" json:"tickets_sold"`
+	SalesStartAt *time.Time     `gorm:"type:timestamptz" json:"sales_start_at"`
+	SalesEndAt   *time.Time     `gorm:"type:timestamptz" json:"sales_end_at"`
+	MaxPerUser   int            `gorm:"not null;default:0" json:"max_per_user"`
 }
 
 // Payment model with UUID primary key.
 type Payment struct {
+	ID                uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt         time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	UserID            uuid.UUID      `gorm:"type:uuid;not null" json:"user_id"`
+	ProviderPaymentID *string        `gorm:"type:varchar(255);unique" json:"provider_payment_id"` // Set once the checkout reached the provider
+	CheckoutURL       string         `gorm:"type:text" json:"checkout_url"`
+	Amount            float64        `gorm:"type:decimal(10,2);not null" json:"amount"`
+	Currency          string         `gorm:"type:varchar(3);not null" json:"currency"`
+	Status            string         `gorm:"type:varchar(50);not null;default:'pending'" json:"status"` // Status: 'pending', 'completed', 'failed', 'refunded'
+	Tickets           []Ticket       `gorm:"foreignKey:PaymentID" json:"tickets"`
+}
+
+// PaymentWebhookEvent stores processed provider callbacks so replays are ignored.
+type PaymentWebhookEvent struct {
+	ID                string    `gorm:"type:varchar(255);primaryKey" json:"id"` // Provider event ID
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"created_at"`
+	Type              string    `gorm:"type:varchar(100);not null" json:"type"`
+	ProviderPaymentID string    `gorm:"type:varchar(255);not null;index" json:"provider_payment_id"`
+}
+
+// Refund model with UUID primary key.
+type Refund struct {
+	ID               uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt        time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	TicketID         uuid.UUID      `gorm:"type:uuid;not null;index" json:"ticket_id"`
+	PaymentID        uuid.UUID      `gorm:"type:uuid;not null;index" json:"payment_id"`
+	EventID          uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	UserID           uuid.UUID      `gorm:"type:uuid;not null;index" json:"user_id"`
+	ProviderRefundID string         `gorm:"type:varchar(255)" json:"provider_refund_id"`
+	Amount           float64        `gorm:"type:decimal(10,2);not null" json:"amount"`
+	Reason           string         `gorm:"type:text" json:"reason"`
+	Status           string         `gorm:"type:varchar(50);not null;default:'pending'" json:"status"` // Status: 'pending', 'completed', 'failed'
+	FailureReason    string         `gorm:"type:text" json:"failure_reason"`
+}
+
+// EventReview model with UUID primary key, one entry of an event's moderation history.
+type EventReview struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	EventID    uuid.UUID `gorm:"type:uuid;not null;index" json:"event_id"`
+	ReviewerID uuid.UUID `gorm:"type:uuid;not null" json:"reviewer_id"`   // Organizer who submitted or admin who decided
+	Action     string    `gorm:"type:varchar(20);not null" json:"action"` // Action: 'submitted', 'approved', 'rejected'
+	Reason     string    `gorm:"type:text" json:"reason"`                 // Rejection reason or what an edit changed
+}
+
+// EventSeries model with UUID primary key.
+type EventSeries struct {
+	ID              uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	OrganizerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Title           string         `gorm:"type:varchar(255);not null" json:"title"`
+	Description     string         `gorm:"type:text" json:"description"`
+	Location        string         `gorm:"type:varchar(255)" json:"location"`
+	VenueID         *uuid.UUID     `gorm:"type:uuid;index" json:"venue_id"`
+	CategoryID      *uuid.UUID     `gorm:"type:uuid;index" json:"category_id"`
+	StartsAt        time.Time      `gorm:"type:timestamptz;not null" json:"starts_at"`
+	DurationMinutes int            `gorm:"not null" json:"duration_minutes"`
+	Timezone        string         `gorm:"type:varchar(64);not null;default:'UTC'" json:"timezone"`
+	RRule           string         `gorm:"column:rrule;type:varchar(255);not null" json:"rrule"`
+	Exceptions      string         `gorm:"type:text" json:"exceptions"` // Comma separated UTC times in RFC 5545 format
+	Capacity        int            `gorm:"not null" json:"capacity"`
+	Price           float64        `gorm:"type:decimal(10,2);not null" json:"price"`
+	Status          string         `gorm:"type:varchar(50);not null;default:'draft'" json:"status"` // Status: 'draft', 'pending_review', 'published'
+	Events          []Event        `gorm:"foreignKey:SeriesID;constraint:OnDelete:SET NULL;" json:"events"`
+
+	OrganizationID *uuid.UUID `gorm:"type:uuid;index" json:"organization_id"` // Carried over to the occurrences
+
+	ReservationTTLMinutes int  `gorm:"not null;default:15" json:"reservation_ttl_minutes"`
+	SalesCutoffMinutes    *int `json:"sales_cutoff_minutes"`
+	RefundPercent         int  `gorm:"not null;default:0" json:"refund_percent"`
+	RefundDeadlineHours   int  `gorm:"not null;default:0" json:"refund_deadline_hours"`
+}
+
+// Organization model with UUID primary key.
+type Organization struct {
+	ID          uuid.UUID                `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt   time.Time                `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time                `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt           `gorm:"index" json:"deleted_at"`
+	Name        string                   `gorm:"type:varchar(255);not null" json:"name"`
+	OwnerID     uuid.UUID                `gorm:"type:uuid;not null;index" json:"owner_id"`
+	Members     []OrganizationMember     `gorm:"constraint:OnDelete:CASCADE;" json:"members"`
+	Invitations []OrganizationInvitation `gorm:"constraint:OnDelete:CASCADE;" json:"invitations"`
+	Events      []Event                  `gorm:"constraint:OnDelete:SET NULL;" json:"events"`
+	Series      []EventSeries            `gorm:"constraint:OnDelete:SET NULL;" json:"series"`
+}
+
+// OrganizationMember model, a user holds one role in an organization.
+type OrganizationMember struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"organization_id"`
+	UserID         uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"user_id"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
+	Role           string    `gorm:"type:varchar(20);not null" json:"role"` // Role: 'owner', 'manager', 'box_office', 'viewer'
+}
+
+// OrganizationInvitation model with UUID primary key.
+type OrganizationInvitation struct {
+	ID             uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	OrganizationID uuid.UUID  `gorm:"type:uuid;not null;index" json:"organization_id"`
+	Email          string     `gorm:"type:varchar(255);not null" json:"email"`
+	Role           string     `gorm:"type:varchar(20);not null" json:"role"`
+	InvitedBy      uuid.UUID  `gorm:"type:uuid;not null" json:"invited_by"`
+	TokenHash      string     `gorm:"type:varchar(64);not null;unique" json:"token_hash"` // SHA-256 of the token, the token itself isn't stored
+	ExpiresAt      time.Time  `gorm:"type:timestamptz;not null" json:"expires_at"`
+	AcceptedAt     *time.Time `gorm:"type:timestamptz" json:"accepted_at"`
+}
+
+// EmailVerification model, the pending verification of a user's email address. Users
+// without one are verified.
+type EmailVerification struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	CodeHash  string    `gorm:"type:varchar(64);not null" json:"code_hash"` // SHA-256 of the code, the code itself isn't stored
+	ExpiresAt time.Time `gorm:"type:timestamptz;not null" json:"expires_at"`
+	SentAt    time.Time `gorm:"type:timestamptz;not null" json:"sent_at"`
+	Attempts  int       `gorm:"not null;default:0" json:"attempts"` // Wrong codes tried since it was sent
+}
+
+// RefreshToken model, one per refresh token handed out. Tokens rotated from the same
+// sign-in share a family.
+type RefreshToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	FamilyID  uuid.UUID  `gorm:"type:uuid;not null;index" json:"family_id"`
+	TokenHash string     `gorm:"type:varchar(64);not null;unique" json:"token_hash"` // SHA-256 of the token, the token itself isn't stored
+	ExpiresAt time.Time  `gorm:"type:timestamptz;not null" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"type:timestamptz" json:"used_at"` // Swapped for the next token of the family
+	RevokedAt *time.Time `gorm:"type:timestamptz" json:"revoked_at"`
+}
+
+// PasswordReset model, one per reset requested. A token works once.
+type PasswordReset struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	TokenHash string     `gorm:"type:varchar(64);not null;unique" json:"token_hash"` // SHA-256 of the token, the token itself isn't stored
+	ExpiresAt time.Time  `gorm:"type:timestamptz;not null" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"type:timestamptz" json:"used_at"` // Set once the password was reset, or a later reset went through
+}
+
+// Role model, a custom role defined by admins next to the built-in ones.
+type Role struct {
+	Name        string           `gorm:"type:varchar(50);primaryKey" json:"name"`
+	CreatedAt   time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+	Description string           `gorm:"type:text" json:"description"`
+	Permissions []RolePermission `gorm:"foreignKey:RoleName;constraint:OnDelete:CASCADE;" json:"permissions"`
+}
+
+// RolePermission is one permission of a custom role.
+type RolePermission struct {
+	RoleName string `gorm:"type:varchar(50);primaryKey" json:"role_name"`
+	Resource string `gorm:"type:varchar(50);primaryKey" json:"resource"`
+	Action   string `gorm:"type:varchar(50);primaryKey" json:"action"`
+	Scope    string `gorm:"type:varchar(10);not null" json:"scope"` // Scope: 'own', 'any'
+}
+
+// Venue model with UUID primary key.
+type Venue struct {
 	ID              uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
 	CreatedAt       time.Time      `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
 	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at"`
-	UserID          uuid.UUID      `gorm:"type:uuid;not null" json:"user_id"`
-	TicketID        uuid.UUID      `gorm:"type:uuid;not null" json:"ticket_id"`
-	StripePaymentID string         `gorm:"type:varchar(255);unique" json:"stripe_payment_id"`
-	Amount          float64        `gorm:"type:decimal(10,2);not null" json:"amount"`
-	Status          string         `gorm:"type:varchar(50);not null;default:'pending'" json:"status"` // Status: 'pending', 'completed', 'failed', 'refunded'
+	OrganizerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Name            string         `gorm:"type:varchar(255);not null" json:"name"`
+	Address         string         `gorm:"type:varchar(255);not null" json:"address"`
+	City            string         `gorm:"type:varchar(100);not null;index" json:"city"`
+	Country         string         `gorm:"type:varchar(100);not null" json:"country"`
+	Latitude        *float64       `gorm:"type:double precision;index" json:"latitude"`
+	Longitude       *float64       `gorm:"type:double precision" json:"longitude"`
+	Timezone        string         `gorm:"type:varchar(64);not null;default:'UTC'" json:"timezone"`
+	DefaultCapacity int            `gorm:"not null" json:"default_capacity"`
+	Events          []Event        `gorm:"constraint:OnDelete:SET NULL;" json:"events"`
+}
+
+// SeatMap model with UUID primary key.
+type SeatMap struct {
+	ID          uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	OrganizerID uuid.UUID      `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	VenueID     *uuid.UUID     `gorm:"type:uuid;index" json:"venue_id"`
+	Name        string         `gorm:"type:varchar(255);not null" json:"name"`
+	SeatsCount  int            `gorm:"not null;default:0" json:"seats_count"`
+	Sections    []SeatSection  `gorm:"constraint:OnDelete:CASCADE;" json:"sections"`
+}
+
+// SeatSection model with UUID primary key.
+type SeatSection struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	SeatMapID uuid.UUID `gorm:"type:uuid;not null;index" json:"seat_map_id"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	Position  int       `gorm:"not null;default:0" json:"position"`
+	Rows      []SeatRow `gorm:"foreignKey:SectionID;constraint:OnDelete:CASCADE;" json:"rows"`
+}
+
+// SeatRow model with UUID primary key.
+type SeatRow struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	SectionID uuid.UUID `gorm:"type:uuid;not null;index" json:"section_id"`
+	Label     string    `gorm:"type:varchar(20);not null" json:"label"`
+	Position  int       `gorm:"not null;default:0" json:"position"`
+	Seats     []Seat    `gorm:"foreignKey:RowID;constraint:OnDelete:CASCADE;" json:"seats"`
+}
+
+// Seat model with UUID primary key.
+type Seat struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	RowID     uuid.UUID `gorm:"type:uuid;not null;index" json:"row_id"`
+	SeatMapID uuid.UUID `gorm:"type:uuid;not null;index" json:"seat_map_id"` // Denormalized to check seats of a map quickly
+	Number    string    `gorm:"type:varchar(20);not null" json:"number"`
+	Position  int       `gorm:"not null;default:0" json:"position"`
+}
+
+// Category model with UUID primary key.
+type Category struct {
+	ID        uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	Name      string         `gorm:"type:varchar(100);not null" json:"name"`
+	Slug      string         `gorm:"type:varchar(100);not null" json:"slug"` // Unique among live categories, see post_migrate.sql
+	Events    []Event        `gorm:"constraint:OnDelete:SET NULL;" json:"events"`
+}
+
+// EventTag is one free-form tag of an event.
+type EventTag struct {
+	EventID uuid.UUID `gorm:"type:uuid;primaryKey" json:"event_id"`
+	Tag     string    `gorm:"type:varchar(50);primaryKey;index" json:"tag"`
 }
@@ -0,0 +1,100 @@
+-- A seat can be held by only one live ticket of an event
+CREATE UNIQUE INDEX IF NOT EXISTS tickets_event_seat_held_idx
+    ON tickets (event_id, seat_id)
+    WHERE seat_id IS NOT NULL
+      AND status IN ('reserved', 'paid')
+      AND deleted_at IS NULL;
+
+-- Event search document, postgres keeps it up to date on every insert and update.
+-- The text search configuration must match values.SearchLanguage.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS events_search_vector_idx
+    ON events USING GIN (search_vector);
+
+-- Typo-tolerant matching on the searched columns
+CREATE INDEX IF NOT EXISTS events_title_trgm_idx
+    ON events USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS events_description_trgm_idx
+    ON events USING GIN (description gin_trgm_ops);
+
+-- Category names stay unique among categories that weren't deleted
+CREATE UNIQUE INDEX IF NOT EXISTS categories_slug_live_idx
+    ON categories (slug)
+    WHERE deleted_at IS NULL;
+
+-- A series materializes each instance of its rule once
+CREATE UNIQUE INDEX IF NOT EXISTS events_series_recurrence_idx
+    ON events (series_id, recurrence_at)
+    WHERE series_id IS NOT NULL
+      AND deleted_at IS NULL;
+
+-- Payments are stored before the provider payment is created, which sets the column later
+ALTER TABLE payments ALTER COLUMN provider_payment_id DROP NOT NULL;
+
+-- Events used to go live on creation as 'active', that status is now 'published'
+UPDATE events SET status = 'published' WHERE status = 'active';
+
+-- Data migrations that must run once are recorded here by name
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    name       varchar(100) PRIMARY KEY,
+    applied_at timestamptz  NOT NULL DEFAULT now()
+);
+
+-- Events used to belong to their organizer alone, every organizer now owns an organization
+-- holding the events and series they created.
+-- Runs once, organizers signing up afterwards get their organization from the application.
+DO $$
+BEGIN
+    -- Instances starting together wait for the first one instead of running it twice
+    PERFORM pg_advisory_xact_lock(hashtext('organizations_backfill'));
+
+    IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'organizations_backfill') THEN
+        INSERT INTO organizations (id, created_at, updated_at, name, owner_id)
+        SELECT uuid_generate_v4(), now(), now(), COALESCE(NULLIF(users.name, ''), users.email), users.id
+        FROM users
+        WHERE users.role = 'organizer'
+          AND users.deleted_at IS NULL
+          AND NOT EXISTS (SELECT 1 FROM organizations WHERE organizations.owner_id = users.id);
+
+        INSERT INTO organization_members (organization_id, user_id, created_at, role)
+        SELECT organizations.id, organizations.owner_id, now(), 'owner'
+        FROM organizations
+        ON CONFLICT DO NOTHING;
+
+        UPDATE events SET organization_id = (
+            SELECT organizations.id FROM organizations
+            WHERE organizations.owner_id = events.organizer_id
+            ORDER BY organizations.created_at
+            LIMIT 1)
+        WHERE organization_id IS NULL;
+
+        UPDATE event_series SET organization_id = (
+            SELECT organizations.id FROM organizations
+            WHERE organizations.owner_id = event_series.organizer_id
+            ORDER BY organizations.created_at
+            LIMIT 1)
+        WHERE organization_id IS NULL;
+
+        INSERT INTO schema_migrations (name) VALUES ('organizations_backfill');
+    END IF;
+END $$;
+
+-- Verified accounts used to be the ones without a pending email verification, they now
+-- carry the time they were verified. Runs once, later accounts are verified by their code.
+DO $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('email_verified_backfill'));
+
+    IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'email_verified_backfill') THEN
+        UPDATE users SET email_verified_at = created_at
+        WHERE email_verified_at IS NULL
+          AND NOT EXISTS (SELECT 1 FROM email_verifications WHERE email_verifications.user_id = users.id);
+
+        INSERT INTO schema_migrations (name) VALUES ('email_verified_backfill');
+    END IF;
+END $$;
@@ -1,2 +1,22 @@
 -- Enable UUID extension
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- Trigram matching for fuzzy event search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Payments are linked to tickets through tickets.payment_id and no longer
+-- reference a single ticket or a Stripe-specific identifier.
+ALTER TABLE IF EXISTS payments DROP COLUMN IF EXISTS ticket_id;
+ALTER TABLE IF EXISTS payments DROP COLUMN IF EXISTS stripe_payment_id;
+
+-- Events had a single date, it became their start and gained an end.
+-- Existing events are given three hours.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'events' AND column_name = 'date') THEN
+        ALTER TABLE events RENAME COLUMN date TO starts_at;
+        ALTER TABLE events ADD COLUMN IF NOT EXISTS ends_at timestamptz;
+        UPDATE events SET ends_at = starts_at + INTERVAL '3 hours' WHERE ends_at IS NULL;
+    END IF;
+END $$;
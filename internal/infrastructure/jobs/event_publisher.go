@@ -0,0 +1,52 @@
+// internal/infrastructure/jobs/event_publisher.go
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+// EventPublisher publishes scheduled events once their publish time has come.
+type EventPublisher struct {
+	repo repository.EventsRepository
+}
+
+func NewEventPublisher(repo repository.EventsRepository) *EventPublisher {
+	return &EventPublisher{
+		repo: repo,
+	}
+}
+
+func (p *EventPublisher) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	go func() {
+		// Run once at startup
+		logrus.Warn("Running initial scheduled events publishing")
+		p.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				p.run(ctx)
+			}
+		}
+	}()
+}
+
+func (p *EventPublisher) run(ctx context.Context) {
+	published, err := p.repo.PublishScheduledEvents(ctx)
+	if err != nil {
+		logrus.Errorf("Error publishing scheduled events: %v", err)
+		return
+	}
+	if published > 0 {
+		logrus.Infof("Published %d scheduled events", published)
+	}
+}
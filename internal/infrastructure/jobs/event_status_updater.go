@@ -10,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// EventStatusUpdater moves events to ongoing when they start and to finished when they end.
 type EventStatusUpdater struct {
 	repo repository.EventsRepository
 }
@@ -21,12 +22,13 @@ func NewEventStatusUpdater(repo repository.EventsRepository) *EventStatusUpdater
 }
 
 func (u *EventStatusUpdater) Start(ctx context.Context) {
-	ticker := time.NewTicker(10 * time.Minute)
+	// Events start and end on the minute, so statuses follow within one
+	ticker := time.NewTicker(time.Minute)
 	go func() {
 		// Run once at startup
-		logrus.Warn("Running initial expired events update")
-		if err := u.repo.UpdateExpiredEvents(ctx); err != nil {
-			logrus.Errorf("Initial expired events update failed: %v", err)
+		logrus.Warn("Running initial event statuses update")
+		if err := u.repo.UpdateEventStatuses(ctx); err != nil {
+			logrus.Errorf("Initial event statuses update failed: %v", err)
 		}
 
 		for {
@@ -35,10 +37,10 @@ func (u *EventStatusUpdater) Start(ctx context.Context) {
 				ticker.Stop()
 				return
 			case <-ticker.C:
-				if err := u.repo.UpdateExpiredEvents(ctx); err != nil {
-					logrus.Errorf("Error updating expired events: %v", err)
+				if err := u.repo.UpdateEventStatuses(ctx); err != nil {
+					logrus.Errorf("Error updating event statuses: %v", err)
 				} else {
-					logrus.Debug("Successfully updated expired events")
+					logrus.Debug("Successfully updated event statuses")
 				}
 			}
 		}
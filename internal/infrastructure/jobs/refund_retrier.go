@@ -0,0 +1,58 @@
+// internal/infrastructure/jobs/refund_retrier.go
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PendingRefunder executes the refunds of cancelled events' tickets still waiting for one.
+type PendingRefunder interface {
+	RefundPendingTickets(ctx context.Context) ([]*entities.Refund, error)
+}
+
+// RefundRetrier retries the refunds of cancelled events that failed or never ran, e.g. because
+// the provider was down or the process stopped right after the cancellation.
+type RefundRetrier struct {
+	refunder PendingRefunder
+}
+
+func NewRefundRetrier(refunder PendingRefunder) *RefundRetrier {
+	return &RefundRetrier{
+		refunder: refunder,
+	}
+}
+
+func (r *RefundRetrier) Start(ctx context.Context) {
+	ticker := time.NewTicker(10 * time.Minute)
+	go func() {
+		// Run once at startup
+		logrus.Warn("Running initial pending refunds retry")
+		r.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				r.run(ctx)
+			}
+		}
+	}()
+}
+
+func (r *RefundRetrier) run(ctx context.Context) {
+	refunds, err := r.refunder.RefundPendingTickets(ctx)
+	if err != nil {
+		logrus.Errorf("Error retrying pending refunds: %v", err)
+		return
+	}
+	if len(refunds) > 0 {
+		logrus.Infof("Retried %d pending refunds", len(refunds))
+	}
+}
@@ -0,0 +1,54 @@
+// internal/infrastructure/jobs/reservation_expirer.go
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ReservationExpirer expires unpaid reservations once their event's hold time
+// has passed and gives the places back to the event. Checkouts still pending
+// by then fail.
+type ReservationExpirer struct {
+	repo repository.TicketsRepository
+}
+
+func NewReservationExpirer(repo repository.TicketsRepository) *ReservationExpirer {
+	return &ReservationExpirer{
+		repo: repo,
+	}
+}
+
+func (e *ReservationExpirer) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	go func() {
+		// Run once at startup
+		logrus.Warn("Running initial expired reservations update")
+		e.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				e.run(ctx)
+			}
+		}
+	}()
+}
+
+func (e *ReservationExpirer) run(ctx context.Context) {
+	expired, err := e.repo.UpdateExpiredTickets(ctx)
+	if err != nil {
+		logrus.Errorf("Error expiring reservations: %v", err)
+		return
+	}
+	if expired > 0 {
+		logrus.Infof("Expired %d reservations", expired)
+	}
+}
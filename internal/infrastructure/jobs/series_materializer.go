@@ -0,0 +1,70 @@
+// internal/infrastructure/jobs/series_materializer.go
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/repository"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SeriesMaterializer keeps the occurrences of every event series created
+// values.SeriesHorizonDays ahead, the horizon moves forward as time passes.
+type SeriesMaterializer struct {
+	repo repository.EventSeriesRepository
+}
+
+func NewSeriesMaterializer(repo repository.EventSeriesRepository) *SeriesMaterializer {
+	return &SeriesMaterializer{
+		repo: repo,
+	}
+}
+
+func (m *SeriesMaterializer) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	go func() {
+		// Run once at startup
+		logrus.Warn("Running initial event series materialization")
+		m.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				m.run(ctx)
+			}
+		}
+	}()
+}
+
+func (m *SeriesMaterializer) run(ctx context.Context) {
+	allSeries, err := m.repo.GetAllSeries(ctx)
+	if err != nil {
+		logrus.Errorf("Error loading event series: %v", err)
+		return
+	}
+
+	now := time.Now()
+	horizon := now.AddDate(0, 0, values.SeriesHorizonDays)
+	for _, series := range allSeries {
+		startTimes, err := series.OccurrencesBetween(now, horizon)
+		if err != nil {
+			logrus.Errorf("Error expanding event series %s: %v", series.ID, err)
+			continue
+		}
+
+		created, err := m.repo.MaterializeOccurrences(ctx, series.ID, startTimes)
+		if err != nil {
+			logrus.Errorf("Error materializing event series %s: %v", series.ID, err)
+			continue
+		}
+		if created > 0 {
+			logrus.Infof("Created %d occurrences of event series %s", created, series.ID)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// internal/infrastructure/mail/mailer.go
+package mail
+
+import (
+	"context"
+)
+
+// Mailer delivers emails to users, verification codes and invitations go out through it.
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailerAppendsMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	mailer := NewFileMailer(path, "no-reply@example.com")
+
+	for _, to := range []string{"first@example.com", "second@example.com"} {
+		if err := mailer.Send(context.Background(), Message{To: to, Subject: "Hello", Body: "Body"}); err != nil {
+			t.Fatalf("Send: %s", err)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(content)
+	if strings.Count(got, "Date: ") != 2 {
+		t.Errorf("file holds %d messages, want 2:\n%s", strings.Count(got, "Date: "), got)
+	}
+	first, second := strings.Index(got, "To: first@example.com"), strings.Index(got, "To: second@example.com")
+	if first < 0 || second < first {
+		t.Errorf("messages missing or out of order:\n%s", got)
+	}
+	if !strings.Contains(got, "From: no-reply@example.com\r\n") {
+		t.Errorf("sender missing:\n%s", got)
+	}
+}
+
+func TestMemoryMailer(t *testing.T) {
+	mailer := NewMemoryMailer()
+	ctx := context.Background()
+
+	if _, ok := mailer.Last("user@example.com"); ok {
+		t.Fatal("Last found a message before any was sent")
+	}
+
+	sent := []Message{
+		{To: "user@example.com", Subject: "First"},
+		{To: "other@example.com", Subject: "Other"},
+		{To: "user@example.com", Subject: "Second"},
+	}
+	for _, message := range sent {
+		if err := mailer.Send(ctx, message); err != nil {
+			t.Fatalf("Send: %s", err)
+		}
+	}
+
+	messages := mailer.Messages()
+	if len(messages) != len(sent) {
+		t.Fatalf("Messages returned %d messages, want %d", len(messages), len(sent))
+	}
+	for i := range sent {
+		if messages[i] != sent[i] {
+			t.Errorf("message %d = %+v, want %+v", i, messages[i], sent[i])
+		}
+	}
+
+	// The returned slice is a copy
+	messages[0].Subject = "Changed"
+	if mailer.Messages()[0].Subject != "First" {
+		t.Error("changing the returned messages changed the mailer's")
+	}
+
+	last, ok := mailer.Last("user@example.com")
+	if !ok || last.Subject != "Second" {
+		t.Errorf("Last = %+v, %v, want the second message", last, ok)
+	}
+}
+
+func TestMailersRespectCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	path := filepath.Join(t.TempDir(), "mail.log")
+	mailers := map[string]Mailer{
+		"file":   NewFileMailer(path, "no-reply@example.com"),
+		"memory": NewMemoryMailer(),
+		"smtp":   NewSMTPMailer("localhost", "0", "", "", "no-reply@example.com"),
+	}
+	for name, mailer := range mailers {
+		t.Run(name, func(t *testing.T) {
+			if err := mailer.Send(ctx, Message{To: "user@example.com"}); !errors.Is(err, context.Canceled) {
+				t.Errorf("Send error = %v, want %v", err, context.Canceled)
+			}
+		})
+	}
+
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("file mailer wrote despite the cancelled context: %v", err)
+	}
+}
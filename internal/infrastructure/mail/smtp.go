@@ -0,0 +1,55 @@
+// internal/infrastructure/mail/smtp.go
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPMailer sends mail through an SMTP server, authenticating with PLAIN auth when
+// a username is set.
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	mailer := &SMTPMailer{
+		addr: net.JoinHostPort(host, port),
+		from: from,
+	}
+	if username != "" {
+		mailer.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return mailer
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, formatMessage(m.from, message)); err != nil {
+		return fmt.Errorf("sending mail to %s: %w", message.To, err)
+	}
+	return nil
+}
+
+// formatMessage builds the RFC 5322 message, header values can't carry line breaks
+func formatMessage(from string, message Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", "")
+
+	var b strings.Builder
+	b.WriteString("From: " + header.Replace(from) + "\r\n")
+	b.WriteString("To: " + header.Replace(message.To) + "\r\n")
+	b.WriteString("Subject: " + header.Replace(message.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
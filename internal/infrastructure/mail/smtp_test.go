@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatMessage(t *testing.T) {
+	message := Message{
+		To:      "user@example.com",
+		Subject: "Verify your email address",
+		Body:    "Your code is 123456\n\nIt expires soon.",
+	}
+
+	got := string(formatMessage("Tickets <no-reply@example.com>", message))
+	want := "From: Tickets <no-reply@example.com>\r\n" +
+		"To: user@example.com\r\n" +
+		"Subject: Verify your email address\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		"Your code is 123456\r\n\r\nIt expires soon."
+	if got != want {
+		t.Errorf("formatMessage =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestFormatMessageKeepsLineBreaksOutOfHeaders(t *testing.T) {
+	message := Message{
+		To:      "user@example.com\r\nBcc: victim@example.com",
+		Subject: "Hello\nBcc: victim@example.com",
+		Body:    "Body",
+	}
+
+	got := string(formatMessage("from@example.com\r\nCc: victim@example.com", message))
+	header, _, _ := strings.Cut(got, "\r\n\r\n")
+	for _, line := range strings.Split(header, "\r\n") {
+		if strings.HasPrefix(line, "Bcc:") || strings.HasPrefix(line, "Cc:") {
+			t.Errorf("injected header line %q in\n%s", line, got)
+		}
+	}
+	if strings.Count(header, "\n") != 4 {
+		t.Errorf("header has %d line breaks, want 4:\n%s", strings.Count(header, "\n"), header)
+	}
+}
@@ -0,0 +1,222 @@
+// internal/infrastructure/payments/fake.go
+package payments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	fakeCheckoutBaseURL = "https://payments.local/checkout/"
+	fakeWebhookTimeout  = 5 * time.Second
+	fakeAmountEpsilon   = 0.001
+)
+
+// FakeProvider is an in-process Provider that keeps payments in memory.
+// Payments stay pending until Succeed, Fail or Refund is called. When a
+// webhook is configured, every state change is also delivered as a signed
+// callback, the same way a real provider would.
+type FakeProvider struct {
+	mu            sync.Mutex
+	payments      map[string]*Payment
+	webhookURL    string
+	webhookSecret string
+	client        *http.Client
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		payments: make(map[string]*Payment),
+		client:   &http.Client{Timeout: fakeWebhookTimeout},
+	}
+}
+
+// SetWebhook configures where signed callbacks are sent.
+func (p *FakeProvider) SetWebhook(url, secret string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.webhookURL = url
+	p.webhookSecret = secret
+}
+
+func (p *FakeProvider) CreatePayment(ctx context.Context, input CreatePaymentInput) (*Payment, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := "fake_pay_" + uuid.NewString()
+	payment := &Payment{
+		ID:          id,
+		Status:      StatusPending,
+		Amount:      input.Amount,
+		Currency:    input.Currency,
+		CheckoutURL: fakeCheckoutBaseURL + id,
+		Reference:   input.Reference,
+	}
+	p.payments[id] = payment
+
+	res := *payment
+	return &res, nil
+}
+
+func (p *FakeProvider) GetPayment(ctx context.Context, paymentID string) (*Payment, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return nil, ErrPaymentNotFound
+	}
+
+	res := *payment
+	return &res, nil
+}
+
+// CreateRefund refunds part or all of a succeeded payment right away and reports it
+// with a payment.refunded callback.
+func (p *FakeProvider) CreateRefund(ctx context.Context, input CreateRefundInput) (*Refund, error) {
+	p.mu.Lock()
+	payment, ok := p.payments[input.PaymentID]
+	if !ok {
+		p.mu.Unlock()
+		return nil, ErrPaymentNotFound
+	}
+	if payment.Status != StatusSucceeded {
+		p.mu.Unlock()
+		return nil, ErrPaymentNotRefundable
+	}
+	if payment.RefundedAmount+input.Amount > payment.Amount+fakeAmountEpsilon {
+		p.mu.Unlock()
+		return nil, ErrRefundExceedsAmount
+	}
+
+	payment.RefundedAmount += input.Amount
+	if payment.RefundedAmount+fakeAmountEpsilon >= payment.Amount {
+		payment.Status = StatusRefunded
+	}
+	refund := &Refund{
+		ID:        "fake_ref_" + uuid.NewString(),
+		PaymentID: payment.ID,
+		Amount:    input.Amount,
+		Status:    StatusSucceeded,
+		Reference: input.Reference,
+	}
+	refundedTotal := payment.RefundedAmount
+	p.mu.Unlock()
+
+	// The refund stands even when the callback isn't delivered, like with a real provider
+	if err := p.sendRefunded(ctx, refund, refundedTotal); err != nil {
+		logrus.Warnf("Fake provider couldn't deliver refund %s: %s", refund.ID, err)
+	}
+
+	return refund, nil
+}
+
+// Succeed marks a pending payment as paid by the customer.
+func (p *FakeProvider) Succeed(ctx context.Context, paymentID string) error {
+	return p.transition(ctx, paymentID, StatusSucceeded, EventPaymentSucceeded)
+}
+
+// Fail marks a pending payment as declined.
+func (p *FakeProvider) Fail(ctx context.Context, paymentID string) error {
+	return p.transition(ctx, paymentID, StatusFailed, EventPaymentFailed)
+}
+
+// Refund refunds what's left of a payment from the provider side, the way a refund made in
+// the provider's dashboard would.
+func (p *FakeProvider) Refund(ctx context.Context, paymentID string) error {
+	p.mu.Lock()
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		p.mu.Unlock()
+		return ErrPaymentNotFound
+	}
+	refund := &Refund{
+		ID:        "fake_ref_" + uuid.NewString(),
+		PaymentID: paymentID,
+		Amount:    payment.Amount - payment.RefundedAmount,
+		Status:    StatusSucceeded,
+	}
+	payment.RefundedAmount = payment.Amount
+	payment.Status = StatusRefunded
+	refundedTotal := payment.RefundedAmount
+	p.mu.Unlock()
+
+	return p.sendRefunded(ctx, refund, refundedTotal)
+}
+
+// SendWebhook delivers a signed callback for the payment without changing its state.
+// Sending the same event twice simulates provider retries.
+func (p *FakeProvider) SendWebhook(ctx context.Context, event WebhookEvent) error {
+	p.mu.Lock()
+	url, secret := p.webhookURL, p.webhookSecret
+	p.mu.Unlock()
+
+	if url == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, payload))
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook rejected with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (p *FakeProvider) sendRefunded(ctx context.Context, refund *Refund, refundedTotal float64) error {
+	return p.SendWebhook(ctx, WebhookEvent{
+		ID:        "fake_evt_" + uuid.NewString(),
+		Type:      EventPaymentRefunded,
+		PaymentID: refund.PaymentID,
+		Refund: &WebhookRefund{
+			ID:            refund.ID,
+			Reference:     refund.Reference,
+			Amount:        refund.Amount,
+			RefundedTotal: refundedTotal,
+		},
+		CreatedAt: time.Now().Unix(),
+	})
+}
+
+func (p *FakeProvider) transition(ctx context.Context, paymentID, status, eventType string) error {
+	p.mu.Lock()
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		p.mu.Unlock()
+		return ErrPaymentNotFound
+	}
+	payment.Status = status
+	p.mu.Unlock()
+
+	return p.SendWebhook(ctx, WebhookEvent{
+		ID:        "fake_evt_" + uuid.NewString(),
+		Type:      eventType,
+		PaymentID: paymentID,
+		CreatedAt: time.Now().Unix(),
+	})
+}
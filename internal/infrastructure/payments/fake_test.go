@@ -0,0 +1,242 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+const testWebhookSecret = "test-secret"
+
+// webhookReceiver verifies the callbacks of a FakeProvider the way the webhook handler does
+type webhookReceiver struct {
+	mu     sync.Mutex
+	events []*WebhookEvent
+	status int
+}
+
+func newWebhookReceiver(t *testing.T, provider *FakeProvider) *webhookReceiver {
+	t.Helper()
+
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		event, err := ParseWebhookEvent(testWebhookSecret, payload, r.Header.Get(SignatureHeader))
+		if err != nil {
+			t.Errorf("callback doesn't verify: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.events = append(receiver.events, event)
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(server.Close)
+
+	provider.SetWebhook(server.URL, testWebhookSecret)
+	return receiver
+}
+
+func (r *webhookReceiver) received() []*WebhookEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*WebhookEvent(nil), r.events...)
+}
+
+func TestFakeProviderTransitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		transition func(p *FakeProvider, ctx context.Context, paymentID string) error
+		wantStatus string
+		wantEvent  string
+	}{
+		{"succeed", (*FakeProvider).Succeed, StatusSucceeded, EventPaymentSucceeded},
+		{"fail", (*FakeProvider).Fail, StatusFailed, EventPaymentFailed},
+		{"refund", (*FakeProvider).Refund, StatusRefunded, EventPaymentRefunded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			provider := NewFakeProvider()
+			receiver := newWebhookReceiver(t, provider)
+
+			payment, err := provider.CreatePayment(ctx, CreatePaymentInput{Amount: 25, Currency: "usd"})
+			if err != nil {
+				t.Fatalf("CreatePayment: %s", err)
+			}
+			if payment.Status != StatusPending {
+				t.Fatalf("new payment status = %q, want %q", payment.Status, StatusPending)
+			}
+
+			if err := tt.transition(provider, ctx, payment.ID); err != nil {
+				t.Fatalf("transition: %s", err)
+			}
+
+			got, err := provider.GetPayment(ctx, payment.ID)
+			if err != nil {
+				t.Fatalf("GetPayment: %s", err)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got.Status, tt.wantStatus)
+			}
+
+			events := receiver.received()
+			if len(events) != 1 {
+				t.Fatalf("received %d callbacks, want 1", len(events))
+			}
+			if events[0].Type != tt.wantEvent || events[0].PaymentID != payment.ID {
+				t.Errorf("callback = %s for %s, want %s for %s", events[0].Type, events[0].PaymentID, tt.wantEvent, payment.ID)
+			}
+		})
+	}
+}
+
+func TestFakeProviderRetriedWebhookKeepsEventID(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider()
+	receiver := newWebhookReceiver(t, provider)
+
+	event := WebhookEvent{ID: "evt_1", Type: EventPaymentSucceeded, PaymentID: "pay_1", CreatedAt: 1}
+	for i := 0; i < 2; i++ {
+		if err := provider.SendWebhook(ctx, event); err != nil {
+			t.Fatalf("SendWebhook: %s", err)
+		}
+	}
+
+	events := receiver.received()
+	if len(events) != 2 || events[0].ID != event.ID || events[1].ID != event.ID {
+		t.Fatalf("received %v, want the same event twice", events)
+	}
+}
+
+func TestFakeProviderRejectedWebhook(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider()
+	receiver := newWebhookReceiver(t, provider)
+	receiver.status = http.StatusInternalServerError
+
+	payment, err := provider.CreatePayment(ctx, CreatePaymentInput{Amount: 10, Currency: "usd"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+
+	if err := provider.Succeed(ctx, payment.ID); err == nil {
+		t.Fatal("Succeed didn't report the rejected callback")
+	}
+}
+
+func TestFakeProviderUnknownPayment(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider()
+
+	if _, err := provider.GetPayment(ctx, "missing"); !errors.Is(err, ErrPaymentNotFound) {
+		t.Errorf("GetPayment error = %v, want %v", err, ErrPaymentNotFound)
+	}
+	if err := provider.Succeed(ctx, "missing"); !errors.Is(err, ErrPaymentNotFound) {
+		t.Errorf("Succeed error = %v, want %v", err, ErrPaymentNotFound)
+	}
+}
+
+func TestFakeProviderCreateRefund(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider()
+
+	payment, err := provider.CreatePayment(ctx, CreatePaymentInput{Amount: 30, Currency: "usd"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+
+	if _, err := provider.CreateRefund(ctx, CreateRefundInput{PaymentID: payment.ID, Amount: 10}); !errors.Is(err, ErrPaymentNotRefundable) {
+		t.Fatalf("refund of a pending payment error = %v, want %v", err, ErrPaymentNotRefundable)
+	}
+
+	// No webhook is configured, the transition only changes the state
+	if err := provider.Succeed(ctx, payment.ID); err != nil {
+		t.Fatalf("Succeed: %s", err)
+	}
+
+	steps := []struct {
+		amount     float64
+		wantErr    error
+		wantStatus string
+	}{
+		{amount: 10, wantStatus: StatusSucceeded},
+		{amount: 25, wantErr: ErrRefundExceedsAmount, wantStatus: StatusSucceeded},
+		{amount: 20, wantStatus: StatusRefunded},
+		{amount: 1, wantErr: ErrPaymentNotRefundable, wantStatus: StatusRefunded},
+	}
+	for i, step := range steps {
+		refund, err := provider.CreateRefund(ctx, CreateRefundInput{PaymentID: payment.ID, Amount: step.amount})
+		if !errors.Is(err, step.wantErr) {
+			t.Fatalf("step %d: error = %v, want %v", i, err, step.wantErr)
+		}
+		if err == nil && (refund.Amount != step.amount || refund.PaymentID != payment.ID) {
+			t.Errorf("step %d: refund = %+v", i, refund)
+		}
+
+		got, err := provider.GetPayment(ctx, payment.ID)
+		if err != nil {
+			t.Fatalf("GetPayment: %s", err)
+		}
+		if got.Status != step.wantStatus {
+			t.Errorf("step %d: status = %q, want %q", i, got.Status, step.wantStatus)
+		}
+	}
+}
+
+func TestFakeProviderReportsRefunds(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider()
+
+	payment, err := provider.CreatePayment(ctx, CreatePaymentInput{Amount: 30, Currency: "usd"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+	if err := provider.Succeed(ctx, payment.ID); err != nil {
+		t.Fatalf("Succeed: %s", err)
+	}
+	receiver := newWebhookReceiver(t, provider)
+
+	refund, err := provider.CreateRefund(ctx, CreateRefundInput{PaymentID: payment.ID, Amount: 10, Reference: "ref_1"})
+	if err != nil {
+		t.Fatalf("CreateRefund: %s", err)
+	}
+	if err := provider.Refund(ctx, payment.ID); err != nil {
+		t.Fatalf("Refund: %s", err)
+	}
+
+	// Each part is reported on its own, with what was refunded of the payment so far
+	want := []WebhookRefund{
+		{ID: refund.ID, Reference: "ref_1", Amount: 10, RefundedTotal: 10},
+		{Amount: 20, RefundedTotal: 30},
+	}
+	events := receiver.received()
+	if len(events) != len(want) {
+		t.Fatalf("received %d callbacks, want %d", len(events), len(want))
+	}
+	for i, event := range events {
+		if event.Type != EventPaymentRefunded || event.PaymentID != payment.ID || event.Refund == nil {
+			t.Fatalf("callback %d = %+v, want a refund of %s", i, event, payment.ID)
+		}
+		got := *event.Refund
+		if want[i].ID == "" {
+			want[i].ID = got.ID
+		}
+		if got != want[i] {
+			t.Errorf("callback %d refund = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// internal/infrastructure/payments/provider.go
+package payments
+
+import (
+	"context"
+	"errors"
+)
+
+// Payment statuses reported by a provider.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusRefunded  = "refunded"
+)
+
+var (
+	ErrPaymentNotFound      = errors.New("provider payment not found")
+	ErrPaymentNotRefundable = errors.New("provider payment cannot be refunded")
+	ErrRefundExceedsAmount  = errors.New("refund exceeds the refundable amount")
+)
+
+// Provider is the external payment processor used to charge users for tickets.
+type Provider interface {
+	CreatePayment(ctx context.Context, input CreatePaymentInput) (*Payment, error)
+	GetPayment(ctx context.Context, paymentID string) (*Payment, error)
+	CreateRefund(ctx context.Context, input CreateRefundInput) (*Refund, error)
+}
+
+type CreatePaymentInput struct {
+	UserID      string
+	Amount      float64
+	Currency    string
+	Description string
+	Reference   string // Our ID of the payment
+}
+
+// Payment is the provider-side view of a payment.
+type Payment struct {
+	ID             string
+	Status         string
+	Amount         float64
+	RefundedAmount float64
+	Currency       string
+	CheckoutURL    string
+	Reference      string
+}
+
+type CreateRefundInput struct {
+	PaymentID string
+	Amount    float64
+	Reason    string
+	Reference string // Our ID of the refund, the provider sends it back with the refund
+}
+
+// Refund is the provider-side view of a (partial) refund of a payment.
+type Refund struct {
+	ID        string
+	PaymentID string
+	Amount    float64
+	Status    string
+	Reference string
+}
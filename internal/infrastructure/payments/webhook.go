@@ -0,0 +1,75 @@
+// internal/infrastructure/payments/webhook.go
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the raw webhook body.
+const SignatureHeader = "X-Payment-Signature"
+
+// Webhook event types sent by the provider.
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+	EventPaymentRefunded  = "payment.refunded"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidEvent     = errors.New("invalid webhook event")
+)
+
+// WebhookEvent is an asynchronous notification about a provider payment.
+type WebhookEvent struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	PaymentID string         `json:"payment_id"`
+	Refund    *WebhookRefund `json:"refund,omitempty"` // Set for payment.refunded
+	CreatedAt int64          `json:"created_at"`
+}
+
+// WebhookRefund is the refund a payment.refunded event reports. A payment can be refunded
+// in several parts, each one is reported on its own.
+type WebhookRefund struct {
+	ID            string  `json:"id"`
+	Reference     string  `json:"reference,omitempty"` // Reference the refund was created with, empty for refunds made at the provider
+	Amount        float64 `json:"amount"`
+	RefundedTotal float64 `json:"refunded_total"` // Everything refunded of the payment so far, this refund included
+}
+
+// Sign returns the signature of payload for the given secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ParseWebhookEvent verifies the payload signature and decodes the event.
+func ParseWebhookEvent(secret string, payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(Sign(secret, payload))
+	if err != nil {
+		return nil, err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, ErrInvalidEvent
+	}
+	if event.ID == "" || event.Type == "" || event.PaymentID == "" {
+		return nil, ErrInvalidEvent
+	}
+	if event.Type == EventPaymentRefunded && (event.Refund == nil || event.Refund.ID == "" || event.Refund.Amount <= 0) {
+		return nil, ErrInvalidEvent
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,45 @@
+package payments
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	valid, err := json.Marshal(WebhookEvent{ID: "evt_1", Type: EventPaymentSucceeded, PaymentID: "pay_1", CreatedAt: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	missingID := []byte(`{"type":"payment.succeeded","payment_id":"pay_1"}`)
+	refundMissing := []byte(`{"id":"evt_1","type":"payment.refunded","payment_id":"pay_1"}`)
+	malformed := []byte(`{"id":`)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		wantErr   error
+	}{
+		{"valid", valid, Sign(testWebhookSecret, valid), nil},
+		{"other secret", valid, Sign("other-secret", valid), ErrInvalidSignature},
+		{"signature of another payload", valid, Sign(testWebhookSecret, missingID), ErrInvalidSignature},
+		{"signature not hex", valid, "not-hex", ErrInvalidSignature},
+		{"no signature", valid, "", ErrInvalidSignature},
+		{"missing fields", missingID, Sign(testWebhookSecret, missingID), ErrInvalidEvent},
+		{"refund event without its refund", refundMissing, Sign(testWebhookSecret, refundMissing), ErrInvalidEvent},
+		{"malformed payload", malformed, Sign(testWebhookSecret, malformed), ErrInvalidEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := ParseWebhookEvent(testWebhookSecret, tt.payload, tt.signature)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (event.ID != "evt_1" || event.Type != EventPaymentSucceeded || event.PaymentID != "pay_1") {
+				t.Errorf("event = %+v", event)
+			}
+		})
+	}
+}
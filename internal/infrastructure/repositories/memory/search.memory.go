@@ -0,0 +1,268 @@
+// infrastructure/repositories/memory/search.memory.go
+package memory
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/domain/repository"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// wordSimilarityThreshold mirrors the pg_trgm.word_similarity_threshold default
+const wordSimilarityThreshold = 0.6
+
+var _ repository.SearchRepository = (*searchRepository)(nil)
+
+// searchRepository is an in-memory SearchRepository for unit tests. It matches
+// words instead of stemmed lexemes, close to but not exactly like postgres.
+type searchRepository struct {
+	mu     sync.RWMutex
+	events map[string]*entities.Event
+}
+
+func NewSearchRepository(events ...*entities.Event) *searchRepository {
+	r := &searchRepository{events: make(map[string]*entities.Event)}
+	for _, event := range events {
+		r.SaveEvent(event)
+	}
+	return r
+}
+
+// SaveEvent adds or replaces an event in the index
+func (r *searchRepository) SaveEvent(event *entities.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[event.ID] = event
+}
+
+// DeleteEvent removes an event from the index
+func (r *searchRepository) DeleteEvent(eventID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.events, eventID)
+}
+
+func (r *searchRepository) SearchEvents(ctx context.Context, filter *entities.EventFilter) ([]*entities.EventSearchHit, int64, error) {
+	terms := searchWords(filter.Search)
+
+	r.mu.RLock()
+	var hits []*entities.EventSearchHit
+	for _, event := range r.events {
+		if !matchesFilter(event, filter) {
+			continue
+		}
+		rank, ok := rankEvent(event, terms)
+		if !ok {
+			continue
+		}
+		hits = append(hits, &entities.EventSearchHit{
+			Event: event,
+			Highlight: entities.EventHighlight{
+				Rank:    rank,
+				Title:   highlight(event.Title, terms),
+				Snippet: highlight(event.Description, terms),
+			},
+		})
+	}
+	r.mu.RUnlock()
+
+	sortHits(hits, filter)
+
+	total := int64(len(hits))
+	if filter.Offset >= len(hits) {
+		return []*entities.EventSearchHit{}, total, nil
+	}
+	hits = hits[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(hits) {
+		hits = hits[:filter.Limit]
+	}
+
+	return hits, total, nil
+}
+
+func matchesFilter(event *entities.Event, filter *entities.EventFilter) bool {
+	switch {
+	case len(filter.Statuses) > 0 && !containsString(filter.Statuses, event.Status):
+		return false
+	case filter.VenueID != "" && event.VenueID != filter.VenueID:
+		return false
+	case filter.CategoryID != "" && event.CategoryID != filter.CategoryID:
+		return false
+	case !hasTags(event, filter.Tags):
+		return false
+	case filter.Location != "" &&
+		!strings.Contains(strings.ToLower(event.Location), strings.ToLower(filter.Location)):
+		return false
+	case filter.DateFrom != nil && event.StartsAt.Before(*filter.DateFrom):
+		return false
+	case filter.DateTo != nil && event.StartsAt.After(*filter.DateTo):
+		return false
+	case filter.PriceMin != nil && event.Price < *filter.PriceMin:
+		return false
+	case filter.PriceMax != nil && event.Price > *filter.PriceMax:
+		return false
+	}
+	// Events don't carry their organizer, an organizer filter matches nothing here
+	return filter.OrganizerID == ""
+}
+
+func hasTags(event *entities.Event, tags []string) bool {
+	for _, tag := range tags {
+		if !containsString(event.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// rankEvent scores an event like the postgres search does, title matches weigh more than description matches
+func rankEvent(event *entities.Event, terms []string) (float64, bool) {
+	if len(terms) == 0 {
+		return 0, false
+	}
+
+	titleWords := searchWords(event.Title)
+	descriptionWords := searchWords(event.Description)
+
+	var rank float64
+	for _, term := range terms {
+		title := bestSimilarity(term, titleWords)
+		description := bestSimilarity(term, descriptionWords)
+		if title < wordSimilarityThreshold && description < wordSimilarityThreshold {
+			return 0, false
+		}
+		rank += title + 0.4*description
+	}
+
+	return rank / float64(len(terms)), true
+}
+
+func sortHits(hits []*entities.EventSearchHit, filter *entities.EventFilter) {
+	less := func(a, b *entities.EventSearchHit) bool {
+		if a.Highlight.Rank != b.Highlight.Rank {
+			return a.Highlight.Rank > b.Highlight.Rank
+		}
+		return a.Event.ID < b.Event.ID
+	}
+
+	if filter.SortBy != "" && filter.SortBy != values.EventSortRelevance {
+		less = func(a, b *entities.EventSearchHit) bool {
+			var before, after bool
+			switch filter.SortBy {
+			case values.EventSortPrice:
+				before, after = a.Event.Price < b.Event.Price, a.Event.Price > b.Event.Price
+			case values.EventSortTitle:
+				before, after = a.Event.Title < b.Event.Title, a.Event.Title > b.Event.Title
+			case values.EventSortCreatedAt:
+				before, after = a.Event.CreatedAt.Before(b.Event.CreatedAt), a.Event.CreatedAt.After(b.Event.CreatedAt)
+			default:
+				before, after = a.Event.StartsAt.Before(b.Event.StartsAt), a.Event.StartsAt.After(b.Event.StartsAt)
+			}
+			if filter.SortDesc {
+				before, after = after, before
+			}
+			if before != after {
+				return before
+			}
+			if filter.SortDesc {
+				return a.Event.ID > b.Event.ID
+			}
+			return a.Event.ID < b.Event.ID
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool { return less(hits[i], hits[j]) })
+}
+
+// highlight wraps the words of text matching a term in the highlight markers, the text
+// is HTML-escaped like the postgres highlights are
+func highlight(text string, terms []string) string {
+	var b strings.Builder
+	word := []rune{}
+
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		w := string(word)
+		if bestSimilarity(strings.ToLower(w), terms) >= wordSimilarityThreshold {
+			b.WriteString(values.SearchHighlightStart + html.EscapeString(w) + values.SearchHighlightStop)
+		} else {
+			b.WriteString(html.EscapeString(w))
+		}
+		word = word[:0]
+	}
+
+	for _, c := range text {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			word = append(word, c)
+			continue
+		}
+		flush()
+		b.WriteString(html.EscapeString(string(c)))
+	}
+	flush()
+
+	return b.String()
+}
+
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+}
+
+func bestSimilarity(term string, words []string) float64 {
+	var best float64
+	for _, word := range words {
+		if similarity := trigramSimilarity(term, word); similarity > best {
+			best = similarity
+		}
+	}
+	return best
+}
+
+// trigramSimilarity is the share of trigrams two words have in common, as pg_trgm computes it
+func trigramSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+func trigrams(word string) map[string]bool {
+	padded := []rune("  " + word + " ")
+	result := make(map[string]bool)
+	for i := 0; i+3 <= len(padded); i++ {
+		result[string(padded[i:i+3])] = true
+	}
+	return result
+}
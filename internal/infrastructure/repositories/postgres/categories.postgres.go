@@ -0,0 +1,160 @@
+// infrastructure/repositories/postgres/categories.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type categoriesRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoriesRepository(db *gorm.DB) *categoriesRepository {
+	return &categoriesRepository{db: db}
+}
+
+func (r *categoriesRepository) CreateCategory(ctx context.Context, category *entities.Category) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategorySlugFree(tx, category.Slug, ""); err != nil {
+			return err
+		}
+
+		gormCategory := toGormCategory(category)
+		if err := tx.Create(gormCategory).Error; err != nil {
+			return err
+		}
+
+		*category = *toDomainCategory(gormCategory)
+		return nil
+	})
+}
+
+func (r *categoriesRepository) GetCategoryByID(ctx context.Context, categoryID string) (*entities.Category, error) {
+	var category models.Category
+	err := r.db.WithContext(ctx).
+		Where("id = ?", categoryID).
+		First(&category).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCategory(&category), nil
+}
+
+func (r *categoriesRepository) GetCategories(ctx context.Context) ([]*entities.Category, error) {
+	var categories []models.Category
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.Category, len(categories))
+	for i, category := range categories {
+		result[i] = toDomainCategory(&category)
+	}
+	return result, nil
+}
+
+func (r *categoriesRepository) UpdateCategory(ctx context.Context, category *entities.Category) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.Category
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", category.ID).
+			First(&existing).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrCategoryNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := checkCategorySlugFree(tx, category.Slug, category.ID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&existing).
+			Updates(map[string]interface{}{"name": category.Name, "slug": category.Slug}).
+			Error; err != nil {
+			return err
+		}
+
+		*category = *toDomainCategory(&existing)
+		return nil
+	})
+}
+
+func (r *categoriesRepository) DeleteCategory(ctx context.Context, categoryID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Event{}).
+			Where("category_id = ?", categoryID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrCategoryInUse
+		}
+
+		result := tx.Where("id = ?", categoryID).Delete(&models.Category{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrCategoryNotFound
+		}
+		return nil
+	})
+}
+
+// checkCategorySlugFree makes sure no other category uses the slug.
+func checkCategorySlugFree(tx *gorm.DB, slug, exceptID string) error {
+	query := tx.Model(&models.Category{}).Where("slug = ?", slug)
+	if exceptID != "" {
+		query = query.Where("id <> ?", exceptID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return types.ErrCategoryExists
+	}
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainCategory(categoryModel *models.Category) *entities.Category {
+	return &entities.Category{
+		ID:        categoryModel.ID.String(),
+		Name:      categoryModel.Name,
+		Slug:      categoryModel.Slug,
+		CreatedAt: categoryModel.CreatedAt,
+	}
+}
+
+func toGormCategory(category *entities.Category) *models.Category {
+	var categoryID uuid.UUID
+	if category.ID != "" {
+		categoryID, _ = validateGormId(category.ID)
+	}
+
+	return &models.Category{
+		ID:   categoryID,
+		Name: category.Name,
+		Slug: category.Slug,
+	}
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"ticket-booking-app-backend/internal/domain/entities"
 	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
 	"ticket-booking-app-backend/internal/infrastructure/types"
 	"ticket-booking-app-backend/pkg/values"
@@ -42,6 +44,34 @@ func (r *commonRepository) CheckIfUserExistsByIdAndRole(ctx context.Context, use
 	return nil
 }
 
+func (r *commonRepository) CheckIfOrganizerIsApproved(ctx context.Context, organizerID string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND status = ?", organizerID, values.UserStatusActive).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking organizer status: %w", err)
+	}
+	if count == 0 {
+		return types.ErrOrganizerNotApproved
+	}
+	return nil
+}
+
+// CheckIfUserIsVerified looks for the time the user confirmed their email, an account whose
+// verification code was lost stays unverified
+func (r *commonRepository) CheckIfUserIsVerified(ctx context.Context, userID string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND email_verified_at IS NOT NULL", userID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking email verification: %w", err)
+	}
+	if count == 0 {
+		return types.ErrEmailNotVerified
+	}
+	return nil
+}
+
 func (r *commonRepository) CheckIfEventExists(ctx context.Context, eventID string) error {
 	var count int64
 	if err := r.db.WithContext(ctx).Model(&models.Event{}).Where("id = ?", eventID).Count(&count).Error; err != nil {
@@ -53,9 +83,20 @@ func (r *commonRepository) CheckIfEventExists(ctx context.Context, eventID strin
 	return nil
 }
 
+func (r *commonRepository) CheckIfCategoryExists(ctx context.Context, categoryID string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Category{}).Where("id = ?", categoryID).Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking category existence: %w", err)
+	}
+	if count == 0 {
+		return types.ErrCategoryNotFound
+	}
+	return nil
+}
+
 func (r *commonRepository) CheckIfEventIsActive(ctx context.Context, eventID string) error {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&models.Event{}).Where("id = ? AND status = ?", eventID, values.EventStatusActive).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&models.Event{}).Where("id = ? AND status IN ?", eventID, entities.PublicEventStatuses).Count(&count).Error; err != nil {
 		return fmt.Errorf("error checking event status: %w", err)
 	}
 	if count == 0 {
@@ -64,13 +105,29 @@ func (r *commonRepository) CheckIfEventIsActive(ctx context.Context, eventID str
 	return nil
 }
 
-func (r *commonRepository) CheckIfEventBelongsToOrganizer(ctx context.Context, eventID, organizerID string) error {
+func (r *commonRepository) CheckEventPermission(ctx context.Context, eventID, userID, permission string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", eventID).
+		Scopes(organizationScope("events", userID, permission)).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking event permission: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("user with id %s lacks the %s permission on event with id %s", userID, permission, eventID)
+	}
+	return nil
+}
+
+func (r *commonRepository) CheckOrganizationPermission(ctx context.Context, organizationID, userID, permission string) error {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&models.Event{}).Where("id = ? AND organizer_id = ?", eventID, organizerID).Count(&count).Error; err != nil {
-		return fmt.Errorf("error checking event ownership: %w", err)
+	if err := r.db.WithContext(ctx).Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND user_id = ? AND role IN ?", organizationID, userID, entities.OrganizationRolesWith(permission)).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking organization permission: %w", err)
 	}
 	if count == 0 {
-		return fmt.Errorf("event with id %s does not belong to organizer with id %s", eventID, organizerID)
+		return fmt.Errorf("user with id %s lacks the %s permission in organization with id %s", userID, permission, organizationID)
 	}
 	return nil
 }
@@ -106,6 +163,60 @@ func (r *commonRepository) CheckIfUserExceededCapacityForEvent(ctx context.Conte
 	return nil
 }
 
+// releaseEventCapacity gives the places held by the tickets back to their events
+// and ticket types.
+func releaseEventCapacity(tx *gorm.DB, tickets []models.Ticket) error {
+	released := make(map[uuid.UUID]int)
+	releasedTypes := make(map[uuid.UUID]int)
+	for _, ticket := range tickets {
+		released[ticket.EventID]++
+		if ticket.TicketTypeID != nil {
+			releasedTypes[*ticket.TicketTypeID]++
+		}
+	}
+
+	for eventID, count := range released {
+		if err := tx.Model(&models.Event{}).
+			Where("id = ?", eventID).
+			Update("tickets_sold", gorm.Expr("GREATEST(tickets_sold - ?, 0)", count)).
+			Error; err != nil {
+			return err
+		}
+	}
+
+	for ticketTypeID, count := range releasedTypes {
+		if err := tx.Model(&models.TicketType{}).
+			Where("id = ?", ticketTypeID).
+			Update("tickets_sold", gorm.Expr("GREATEST(tickets_sold - ?, 0)", count)).
+			Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// organizationScope limits a query on events or event series to the rows the user has the permission on:
+// the ones of organizations where their role grants it, and the ones they created outside any organization.
+func organizationScope(table, userID, permission string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		organizations := db.Session(&gorm.Session{NewDB: true}).
+			Model(&models.OrganizationMember{}).
+			Select("organization_id").
+			Where("user_id = ? AND role IN ?", userID, entities.OrganizationRolesWith(permission))
+
+		return db.Where(
+			fmt.Sprintf("((%[1]s.organization_id IS NULL AND %[1]s.organizer_id = ?) OR %[1]s.organization_id IN (?))", table),
+			userID, organizations,
+		)
+	}
+}
+
+// containsPattern builds an ILIKE pattern matching the term anywhere, with its wildcards escaped.
+func containsPattern(term string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
+	return "%" + escaped + "%"
+}
+
 // validateGormId validates the GORM ID.
 func validateGormId(id string) (uuid.UUID, error) {
 	if id == "" {
@@ -117,3 +228,39 @@ func validateGormId(id string) (uuid.UUID, error) {
 	}
 	return newId, nil
 }
+
+// optionalGormId parses an optional reference, empty or malformed IDs give nil.
+func optionalGormId(id string) *uuid.UUID {
+	if id == "" {
+		return nil
+	}
+	newId, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+	return &newId
+}
+
+// optionalId formats an optional reference, nil gives an empty string.
+func optionalId(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// optionalString stores an empty string as NULL.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// stringValue reads an optional string, NULL gives an empty string.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,127 @@
+// infrastructure/repositories/postgres/email_verifications.postgres.go
+package postgres
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type emailVerificationsRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailVerificationsRepository(db *gorm.DB) *emailVerificationsRepository {
+	return &emailVerificationsRepository{db: db}
+}
+
+// Create operations
+
+func (r *emailVerificationsRepository) SaveVerification(ctx context.Context, verification *entities.EmailVerification) error {
+	userID, err := validateGormId(verification.UserID)
+	if err != nil {
+		return err
+	}
+
+	gormVerification := &models.EmailVerification{
+		UserID:    userID,
+		CodeHash:  verification.CodeHash,
+		ExpiresAt: verification.ExpiresAt,
+		SentAt:    verification.SentAt,
+	}
+
+	// A new code starts over with no attempts
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"code_hash":  gormVerification.CodeHash,
+				"expires_at": gormVerification.ExpiresAt,
+				"sent_at":    gormVerification.SentAt,
+				"attempts":   0,
+			}),
+		}).
+		Create(gormVerification).Error
+}
+
+// Read operations
+
+func (r *emailVerificationsRepository) GetVerification(ctx context.Context, userID string) (*entities.EmailVerification, error) {
+	var verification models.EmailVerification
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&verification).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEmailAlreadyVerified
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainEmailVerification(&verification), nil
+}
+
+// Delete operations
+
+func (r *emailVerificationsRepository) VerifyEmail(ctx context.Context, userID, codeHash string) error {
+	wrongCode := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var verification models.EmailVerification
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).
+			First(&verification).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrVerificationCodeInvalid
+		}
+		if err != nil {
+			return err
+		}
+
+		if verification.Attempts >= values.MaxVerificationAttempts || !time.Now().Before(verification.ExpiresAt) {
+			return types.ErrVerificationCodeInvalid
+		}
+
+		// The attempt is committed before the wrong code is reported
+		if subtle.ConstantTimeCompare([]byte(verification.CodeHash), []byte(codeHash)) != 1 {
+			wrongCode = true
+			return tx.Model(&verification).
+				Update("attempts", gorm.Expr("attempts + 1")).Error
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ?", verification.UserID).
+			Update("email_verified_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&verification).Error
+	})
+	if err != nil {
+		return err
+	}
+	if wrongCode {
+		return types.ErrVerificationCodeInvalid
+	}
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainEmailVerification(verificationModel *models.EmailVerification) *entities.EmailVerification {
+	return &entities.EmailVerification{
+		UserID:    verificationModel.UserID.String(),
+		CodeHash:  verificationModel.CodeHash,
+		ExpiresAt: verificationModel.ExpiresAt,
+		SentAt:    verificationModel.SentAt,
+		Attempts:  verificationModel.Attempts,
+	}
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+func TestUnverifiedWithoutVerificationCode(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	user := createTestUser(t, db, values.UserRole)
+	common := NewCommonRepository(db)
+
+	// Saving the code failed at sign up, the account must not pass for verified
+	if err := common.CheckIfUserIsVerified(ctx, user.ID.String()); !errors.Is(err, types.ErrEmailNotVerified) {
+		t.Fatalf("CheckIfUserIsVerified error = %v, want %v", err, types.ErrEmailNotVerified)
+	}
+
+	repo := NewEmailVerificationsRepository(db)
+	verification := &entities.EmailVerification{
+		UserID:    user.ID.String(),
+		CodeHash:  "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+		SentAt:    time.Now(),
+	}
+	if err := repo.SaveVerification(ctx, verification); err != nil {
+		t.Fatalf("SaveVerification: %s", err)
+	}
+	if err := repo.VerifyEmail(ctx, user.ID.String(), "hash"); err != nil {
+		t.Fatalf("VerifyEmail: %s", err)
+	}
+
+	if err := common.CheckIfUserIsVerified(ctx, user.ID.String()); err != nil {
+		t.Errorf("CheckIfUserIsVerified after verifying: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+// infrastructure/repositories/postgres/event_reviews.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"gorm.io/gorm"
+)
+
+type eventReviewsRepository struct {
+	db *gorm.DB
+}
+
+func NewEventReviewsRepository(db *gorm.DB) *eventReviewsRepository {
+	return &eventReviewsRepository{db: db}
+}
+
+// SaveReview records the review and moves its event from fromStatus, the status the caller
+// validated the review against, to status. An event that left fromStatus meanwhile is refused.
+func (r *eventReviewsRepository) SaveReview(ctx context.Context, review *entities.EventReview, fromStatus, status string, publishAt *time.Time) error {
+	gormReview, err := toGormEventReview(review)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Event{}).
+			Where("id = ? AND status = ?", gormReview.EventID, fromStatus).
+			Updates(map[string]interface{}{"status": status, "publish_at": publishAt})
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			err := eventUpdateMissed(tx, review.EventID, "")
+			// Another reviewer decided first, or the organizer withdrew the event
+			if errors.Is(err, types.ErrInvalidEventTransition) && fromStatus == values.EventStatusPendingReview {
+				return types.ErrEventNotPendingReview
+			}
+			return err
+		}
+
+		if err := tx.Create(gormReview).Error; err != nil {
+			return err
+		}
+
+		*review = *toDomainEventReview(gormReview)
+		return nil
+	})
+}
+
+func (r *eventReviewsRepository) GetEventReviews(ctx context.Context, eventID string) ([]*entities.EventReview, error) {
+	var reviews []models.EventReview
+	if err := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.EventReview, len(reviews))
+	for i, review := range reviews {
+		result[i] = toDomainEventReview(&review)
+	}
+	return result, nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainEventReview(reviewModel *models.EventReview) *entities.EventReview {
+	return &entities.EventReview{
+		ID:         reviewModel.ID.String(),
+		EventID:    reviewModel.EventID.String(),
+		ReviewerID: reviewModel.ReviewerID.String(),
+		Action:     reviewModel.Action,
+		Reason:     reviewModel.Reason,
+		CreatedAt:  reviewModel.CreatedAt,
+	}
+}
+
+func toGormEventReview(review *entities.EventReview) (*models.EventReview, error) {
+	eventID, err := validateGormId(review.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerID, err := validateGormId(review.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EventReview{
+		EventID:    eventID,
+		ReviewerID: reviewerID,
+		Action:     review.Action,
+		Reason:     review.Reason,
+	}, nil
+}
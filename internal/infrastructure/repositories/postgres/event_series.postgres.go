@@ -0,0 +1,596 @@
+// infrastructure/repositories/postgres/event_series.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// seriesEditableColumns are the columns an organizer can change through UpdateSeries.
+var seriesEditableColumns = []string{
+	"title", "description", "location", "venue_id", "category_id", "starts_at", "duration_minutes", "timezone",
+	"rrule", "exceptions", "capacity", "price",
+	"reservation_ttl_minutes", "sales_cutoff_minutes", "refund_percent", "refund_deadline_hours",
+}
+
+// exceptionLayout is the UTC date-time format of RFC 5545 the exceptions are stored in
+const exceptionLayout = "20060102T150405Z"
+
+type eventSeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewEventSeriesRepository(db *gorm.DB) *eventSeriesRepository {
+	return &eventSeriesRepository{db: db}
+}
+
+// Create operations
+
+func (r *eventSeriesRepository) CreateSeries(ctx context.Context, series *entities.EventSeries) error {
+	gormSeries, err := toGormSeries(series)
+	if err != nil {
+		return err
+	}
+	// New series stay hidden until their organizer publishes them
+	gormSeries.Status = values.EventStatusDraft
+
+	if err := r.db.WithContext(ctx).Create(gormSeries).Error; err != nil {
+		return err
+	}
+
+	*series = *toDomainSeries(gormSeries)
+	return nil
+}
+
+func (r *eventSeriesRepository) MaterializeOccurrences(ctx context.Context, seriesID string, startTimes []time.Time) (int64, error) {
+	var created int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The lock keeps concurrent runs from creating the same occurrence twice
+		series, err := lockSeries(tx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		created, err = createMissingOccurrences(tx, series, startTimes)
+		return err
+	})
+	return created, err
+}
+
+// Read operations
+
+func (r *eventSeriesRepository) GetSeriesByID(ctx context.Context, seriesID string) (*entities.EventSeries, error) {
+	var series models.EventSeries
+	err := r.db.WithContext(ctx).
+		Where("id = ?", seriesID).
+		First(&series).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEventSeriesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainSeries(&series), nil
+}
+
+func (r *eventSeriesRepository) GetSeriesByOrganizer(ctx context.Context, organizerID string) ([]*entities.EventSeries, error) {
+	var series []models.EventSeries
+	if err := r.db.WithContext(ctx).
+		Scopes(organizationScope("event_series", organizerID, values.OrganizationPermissionViewEvents)).
+		Order("created_at DESC").
+		Find(&series).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainSeriesList(series), nil
+}
+
+func (r *eventSeriesRepository) GetAllSeries(ctx context.Context) ([]*entities.EventSeries, error) {
+	var series []models.EventSeries
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&series).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainSeriesList(series), nil
+}
+
+func (r *eventSeriesRepository) GetUpcomingOccurrences(ctx context.Context, seriesID string) ([]*entities.Event, error) {
+	var events []models.Event
+	if err := r.db.WithContext(ctx).
+		Preload("Tags").
+		Where("series_id = ? AND ends_at > ?", seriesID, time.Now()).
+		Order("starts_at ASC").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainEvents(events), nil
+}
+
+// Update operations
+
+func (r *eventSeriesRepository) UpdateSeries(ctx context.Context, series *entities.EventSeries, startTimes []time.Time, review *entities.EventReview) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockSeries(tx, series.ID)
+		if err != nil {
+			return err
+		}
+
+		gormSeries, err := toGormSeries(series)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(existing).
+			Select(seriesEditableColumns).
+			Updates(gormSeries).Error; err != nil {
+			return err
+		}
+
+		gormSeries.OrganizerID = existing.OrganizerID
+		gormSeries.OrganizationID = existing.OrganizationID
+		gormSeries.Status = existing.Status
+		gormSeries.CreatedAt = existing.CreatedAt
+
+		// Occurrences moved on their own keep their times unless the schedule itself changed
+		scheduleChanged := seriesScheduleChanged(existing, gormSeries)
+
+		// Under review a live series stops creating live occurrences until a reviewer publishes it again
+		if review != nil && existing.Status == values.EventStatusPublished &&
+			(scheduleChanged || len(seriesContentChanges(existing, gormSeries)) > 0) {
+			gormSeries.Status = values.EventStatusPendingReview
+			if err := tx.Model(existing).Update("status", gormSeries.Status).Error; err != nil {
+				return err
+			}
+		}
+
+		// Occurrences that started or ended keep the settings they ran with
+		var upcoming []models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("series_id = ? AND starts_at > ? AND status IN ?", gormSeries.ID, time.Now(),
+				[]string{values.EventStatusDraft, values.EventStatusPendingReview, values.EventStatusScheduled, values.EventStatusPublished}).
+			Order("recurrence_at").
+			Find(&upcoming).Error; err != nil {
+			return err
+		}
+
+		matches, err := matchOccurrences(tx, existing, series, upcoming, startTimes)
+		if err != nil {
+			return err
+		}
+
+		for _, occurrence := range upcoming {
+			startsAt, matched := matches[occurrence.ID]
+			if !matched && occurrence.TicketsSold == 0 {
+				if err := removeOccurrence(tx, &occurrence); err != nil {
+					return err
+				}
+				continue
+			}
+
+			fields := seriesOccurrenceFields(gormSeries)
+			if occurrence.SeatMapID == nil {
+				// Sold tickets and the places of the tiers stay valid, the occurrence is locked above
+				if err := checkEventCapacity(tx, &occurrence, gormSeries.Capacity); err != nil {
+					return err
+				}
+				fields["capacity"] = gormSeries.Capacity
+			}
+			endsAt := occurrence.EndsAt
+			if matched && scheduleChanged {
+				endsAt = startsAt.Add(time.Duration(gormSeries.DurationMinutes) * time.Minute)
+				fields["starts_at"] = startsAt
+				fields["ends_at"] = endsAt
+				fields["recurrence_at"] = startsAt
+			} else {
+				startsAt = occurrence.StartsAt
+			}
+
+			// Live occurrences whose content or dates change go back to review like single events
+			var changes []string
+			if review != nil && (occurrence.Status == values.EventStatusPublished || occurrence.Status == values.EventStatusScheduled) {
+				changes = seriesContentChanges(&models.EventSeries{
+					Title:       occurrence.Title,
+					Description: occurrence.Description,
+					Location:    occurrence.Location,
+					VenueID:     occurrence.VenueID,
+				}, gormSeries)
+				if !startsAt.Equal(occurrence.StartsAt) || !endsAt.Equal(occurrence.EndsAt) {
+					changes = append(changes, "dates")
+				}
+			}
+			if len(changes) > 0 {
+				fields["status"] = values.EventStatusPendingReview
+			}
+
+			if err := tx.Model(&occurrence).Updates(fields).Error; err != nil {
+				return err
+			}
+
+			if len(changes) > 0 {
+				reviewerID, err := validateGormId(review.ReviewerID)
+				if err != nil {
+					return err
+				}
+				if err := tx.Create(&models.EventReview{
+					EventID:    occurrence.ID,
+					ReviewerID: reviewerID,
+					Action:     review.Action,
+					Reason:     "changed " + strings.Join(changes, ", "),
+				}).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		if _, err := createMissingOccurrences(tx, gormSeries, startTimes); err != nil {
+			return err
+		}
+
+		*series = *toDomainSeries(gormSeries)
+		return nil
+	})
+}
+
+func (r *eventSeriesRepository) PublishSeries(ctx context.Context, seriesID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.EventSeries{}).
+			Where("id = ?", seriesID).
+			Update("status", values.EventStatusPublished)
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrEventSeriesNotFound
+		}
+
+		return tx.Model(&models.Event{}).
+			Where("series_id = ? AND status IN ? AND starts_at > ?", seriesID,
+				[]string{values.EventStatusDraft, values.EventStatusPendingReview}, time.Now()).
+			Update("status", values.EventStatusPublished).Error
+	})
+}
+
+// Validation operations
+
+func (r *eventSeriesRepository) ValidateSeriesPermission(ctx context.Context, seriesID, userID, permission string) error {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.EventSeries{}).
+		Where("id = ?", seriesID).
+		Scopes(organizationScope("event_series", userID, permission)).
+		Count(&count).Error
+
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrEventSeriesNotFound
+	}
+	return nil
+}
+
+// Helper functions
+
+func lockSeries(tx *gorm.DB, seriesID string) (*models.EventSeries, error) {
+	var series models.EventSeries
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", seriesID).
+		First(&series).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEventSeriesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// matchOccurrences pairs the upcoming occurrences with the start times of the new schedule: the
+// same instance first, then one on the same day, then the one at the same position in the rule.
+// A changed time, weekday or rule thus moves the occurrences, sold ones included, instead of
+// replacing them. Occurrences left without a start time are missing from the result.
+func matchOccurrences(tx *gorm.DB, before *models.EventSeries, after *entities.EventSeries, occurrences []models.Event, startTimes []time.Time) (map[uuid.UUID]time.Time, error) {
+	matches := make(map[uuid.UUID]time.Time, len(occurrences))
+	if len(occurrences) == 0 || len(startTimes) == 0 {
+		return matches, nil
+	}
+
+	// Start times of occurrences that were cancelled, removed or already ran aren't handed out again
+	ids := make([]uuid.UUID, len(occurrences))
+	for i, occurrence := range occurrences {
+		ids[i] = occurrence.ID
+	}
+	var claimed []time.Time
+	if err := tx.Unscoped().Model(&models.Event{}).
+		Where("series_id = ? AND recurrence_at IN ? AND id NOT IN ?", before.ID, startTimes, ids).
+		Pluck("recurrence_at", &claimed).Error; err != nil {
+		return nil, err
+	}
+	taken := make(map[int64]bool, len(startTimes))
+	for _, recurrenceAt := range claimed {
+		taken[recurrenceAt.Unix()] = true
+	}
+
+	// Positions count every instance of the rule from its first one, exceptions included, so
+	// leaving out one date doesn't shift the ones after it
+	horizon := time.Now().AddDate(0, 0, values.SeriesHorizonDays)
+	oldPositions, err := toDomainSeries(before).OccurrencePositions(horizon)
+	if err != nil {
+		return nil, err
+	}
+	newPositions, err := after.OccurrencePositions(horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(after.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	sameDay := func(a, b time.Time) bool {
+		a, b = a.In(location), b.In(location)
+		return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+	}
+
+	match := func(same func(recurrenceAt, startsAt time.Time) bool) {
+		for _, occurrence := range occurrences {
+			if occurrence.RecurrenceAt == nil {
+				continue
+			}
+			if _, ok := matches[occurrence.ID]; ok {
+				continue
+			}
+			for _, startsAt := range startTimes {
+				if !taken[startsAt.Unix()] && same(*occurrence.RecurrenceAt, startsAt) {
+					matches[occurrence.ID] = startsAt
+					taken[startsAt.Unix()] = true
+					break
+				}
+			}
+		}
+	}
+	match(func(recurrenceAt, startsAt time.Time) bool {
+		return recurrenceAt.Equal(startsAt)
+	})
+	match(sameDay)
+	match(func(recurrenceAt, startsAt time.Time) bool {
+		oldPosition, ok := oldPositions[recurrenceAt.Unix()]
+		newPosition, ok2 := newPositions[startsAt.Unix()]
+		return ok && ok2 && oldPosition == newPosition
+	})
+
+	return matches, nil
+}
+
+// removeOccurrence drops an occurrence that left the schedule. Occurrences without any tickets are removed
+// for good so they come back if the schedule does, the others are kept soft deleted for their ticket history.
+func removeOccurrence(tx *gorm.DB, occurrence *models.Event) error {
+	var tickets int64
+	if err := tx.Model(&models.Ticket{}).
+		Where("event_id = ?", occurrence.ID).
+		Count(&tickets).Error; err != nil {
+		return err
+	}
+
+	if tickets == 0 {
+		return tx.Unscoped().Delete(occurrence).Error
+	}
+	return tx.Delete(occurrence).Error
+}
+
+// createMissingOccurrences creates the occurrences of the series for the start times it doesn't have yet.
+// Cancelled and deleted occurrences count as existing, so removing one doesn't bring it back.
+func createMissingOccurrences(tx *gorm.DB, series *models.EventSeries, startTimes []time.Time) (int64, error) {
+	if len(startTimes) == 0 {
+		return 0, nil
+	}
+
+	var existing []time.Time
+	if err := tx.Unscoped().Model(&models.Event{}).
+		Where("series_id = ? AND recurrence_at IN ?", series.ID, startTimes).
+		Pluck("recurrence_at", &existing).Error; err != nil {
+		return 0, err
+	}
+
+	materialized := make(map[int64]bool, len(existing))
+	for _, recurrenceAt := range existing {
+		materialized[recurrenceAt.Unix()] = true
+	}
+
+	var occurrences []models.Event
+	for _, startsAt := range startTimes {
+		if !materialized[startsAt.Unix()] {
+			occurrences = append(occurrences, seriesOccurrence(series, startsAt))
+		}
+	}
+	if len(occurrences) == 0 {
+		return 0, nil
+	}
+
+	if err := tx.Create(&occurrences).Error; err != nil {
+		return 0, err
+	}
+	return int64(len(occurrences)), nil
+}
+
+func seriesOccurrence(series *models.EventSeries, startsAt time.Time) models.Event {
+	seriesID := series.ID
+	recurrenceAt := startsAt
+
+	return models.Event{
+		OrganizerID:  series.OrganizerID,
+		Title:        series.Title,
+		Description:  series.Description,
+		Location:     series.Location,
+		VenueID:      series.VenueID,
+		CategoryID:   series.CategoryID,
+		StartsAt:     startsAt,
+		EndsAt:       startsAt.Add(time.Duration(series.DurationMinutes) * time.Minute),
+		Capacity:     series.Capacity,
+		Price:        series.Price,
+		Status:       series.Status,
+		SeriesID:     &seriesID,
+		RecurrenceAt: &recurrenceAt,
+
+		OrganizationID: series.OrganizationID,
+
+		ReservationTTLMinutes: series.ReservationTTLMinutes,
+		SalesCutoffMinutes:    series.SalesCutoffMinutes,
+		RefundPercent:         series.RefundPercent,
+		RefundDeadlineHours:   series.RefundDeadlineHours,
+	}
+}
+
+// seriesOccurrenceFields are the settings a series edit carries over to its occurrences
+func seriesOccurrenceFields(series *models.EventSeries) map[string]interface{} {
+	return map[string]interface{}{
+		"title":                   series.Title,
+		"description":             series.Description,
+		"location":                series.Location,
+		"venue_id":                series.VenueID,
+		"category_id":             series.CategoryID,
+		"price":                   series.Price,
+		"reservation_ttl_minutes": series.ReservationTTLMinutes,
+		"sales_cutoff_minutes":    series.SalesCutoffMinutes,
+		"refund_percent":          series.RefundPercent,
+		"refund_deadline_hours":   series.RefundDeadlineHours,
+	}
+}
+
+// seriesContentChanges lists what an edit changes of the series that moderation looks at
+func seriesContentChanges(before, after *models.EventSeries) []string {
+	var changes []string
+	if before.Title != after.Title {
+		changes = append(changes, "title")
+	}
+	if before.Description != after.Description {
+		changes = append(changes, "description")
+	}
+	if before.Location != after.Location || optionalId(before.VenueID) != optionalId(after.VenueID) {
+		changes = append(changes, "location")
+	}
+	return changes
+}
+
+func seriesScheduleChanged(before, after *models.EventSeries) bool {
+	return !before.StartsAt.Equal(after.StartsAt) ||
+		before.DurationMinutes != after.DurationMinutes ||
+		before.Timezone != after.Timezone ||
+		before.RRule != after.RRule ||
+		before.Exceptions != after.Exceptions
+}
+
+func formatExceptions(exceptions []time.Time) string {
+	formatted := make([]string, len(exceptions))
+	for i, exception := range exceptions {
+		formatted[i] = exception.UTC().Format(exceptionLayout)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func parseExceptions(exceptions string) []time.Time {
+	if exceptions == "" {
+		return nil
+	}
+
+	var result []time.Time
+	for _, exception := range strings.Split(exceptions, ",") {
+		if parsed, err := time.Parse(exceptionLayout, exception); err == nil {
+			result = append(result, parsed)
+		}
+	}
+	return result
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainSeriesList(series []models.EventSeries) []*entities.EventSeries {
+	result := make([]*entities.EventSeries, len(series))
+	for i, s := range series {
+		result[i] = toDomainSeries(&s)
+	}
+	return result
+}
+
+func toDomainSeries(seriesModel *models.EventSeries) *entities.EventSeries {
+	return &entities.EventSeries{
+		ID:              seriesModel.ID.String(),
+		OrganizerID:     seriesModel.OrganizerID.String(),
+		Title:           seriesModel.Title,
+		Description:     seriesModel.Description,
+		Location:        seriesModel.Location,
+		VenueID:         optionalId(seriesModel.VenueID),
+		CategoryID:      optionalId(seriesModel.CategoryID),
+		StartsAt:        seriesModel.StartsAt,
+		DurationMinutes: seriesModel.DurationMinutes,
+		Timezone:        seriesModel.Timezone,
+		RRule:           seriesModel.RRule,
+		Exceptions:      parseExceptions(seriesModel.Exceptions),
+		Capacity:        seriesModel.Capacity,
+		Price:           seriesModel.Price,
+		Status:          seriesModel.Status,
+		CreatedAt:       seriesModel.CreatedAt,
+
+		OrganizationID: optionalId(seriesModel.OrganizationID),
+
+		ReservationTTLMinutes: seriesModel.ReservationTTLMinutes,
+		SalesCutoffMinutes:    seriesModel.SalesCutoffMinutes,
+		RefundPercent:         seriesModel.RefundPercent,
+		RefundDeadlineHours:   seriesModel.RefundDeadlineHours,
+	}
+}
+
+func toGormSeries(series *entities.EventSeries) (*models.EventSeries, error) {
+	var seriesID uuid.UUID
+	var err error
+	if series.ID != "" {
+		seriesID, err = validateGormId(series.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	organizerID, err := validateGormId(series.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EventSeries{
+		ID:              seriesID,
+		OrganizerID:     organizerID,
+		Title:           series.Title,
+		Description:     series.Description,
+		Location:        series.Location,
+		VenueID:         optionalGormId(series.VenueID),
+		CategoryID:      optionalGormId(series.CategoryID),
+		StartsAt:        series.StartsAt,
+		DurationMinutes: series.DurationMinutes,
+		Timezone:        series.Timezone,
+		RRule:           series.RRule,
+		Exceptions:      formatExceptions(series.Exceptions),
+		Capacity:        series.Capacity,
+		Price:           series.Price,
+		Status:          series.Status,
+
+		OrganizationID: optionalGormId(series.OrganizationID),
+
+		ReservationTTLMinutes: series.ReservationTTLMinutes,
+		SalesCutoffMinutes:    series.SalesCutoffMinutes,
+		RefundPercent:         series.RefundPercent,
+		RefundDeadlineHours:   series.RefundDeadlineHours,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+// createTestSeries creates a published weekly series with its occurrences of the coming weeks
+func createTestSeries(t *testing.T, repo *eventSeriesRepository, organizerID string) *entities.EventSeries {
+	t.Helper()
+
+	ctx := context.Background()
+	series := &entities.EventSeries{
+		OrganizerID:     organizerID,
+		Title:           "Test series",
+		StartsAt:        time.Now().Add(24 * time.Hour).Truncate(time.Second),
+		DurationMinutes: 120,
+		Timezone:        "UTC",
+		RRule:           "FREQ=WEEKLY;COUNT=3",
+		Capacity:        10,
+		Price:           10,
+	}
+	if err := repo.CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries: %s", err)
+	}
+
+	startTimes, err := series.OccurrencesBetween(time.Now(), time.Now().AddDate(0, 0, values.SeriesHorizonDays))
+	if err != nil {
+		t.Fatalf("OccurrencesBetween: %s", err)
+	}
+	if _, err := repo.MaterializeOccurrences(ctx, series.ID, startTimes); err != nil {
+		t.Fatalf("MaterializeOccurrences: %s", err)
+	}
+	if err := repo.PublishSeries(ctx, series.ID); err != nil {
+		t.Fatalf("PublishSeries: %s", err)
+	}
+	series.Status = values.EventStatusPublished
+	return series
+}
+
+func TestUpdateSeriesUnderReview(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	organizer := createTestUser(t, db, values.OrganizerRole)
+	repo := NewEventSeriesRepository(db)
+	series := createTestSeries(t, repo, organizer.ID.String())
+
+	startTimes, err := series.OccurrencesBetween(time.Now(), time.Now().AddDate(0, 0, values.SeriesHorizonDays))
+	if err != nil {
+		t.Fatalf("OccurrencesBetween: %s", err)
+	}
+
+	series.Title = "Renamed series"
+	review := &entities.EventReview{ReviewerID: organizer.ID.String(), Action: values.ReviewActionSubmitted}
+	if err := repo.UpdateSeries(ctx, series, startTimes, review); err != nil {
+		t.Fatalf("UpdateSeries: %s", err)
+	}
+	if series.Status != values.EventStatusPendingReview {
+		t.Errorf("series status = %q, want %q", series.Status, values.EventStatusPendingReview)
+	}
+
+	var occurrences []models.Event
+	if err := db.Where("series_id = ?", series.ID).Find(&occurrences).Error; err != nil {
+		t.Fatalf("reading occurrences: %s", err)
+	}
+	if len(occurrences) != len(startTimes) {
+		t.Fatalf("%d occurrences, want %d", len(occurrences), len(startTimes))
+	}
+	for _, occurrence := range occurrences {
+		if occurrence.Status != values.EventStatusPendingReview || occurrence.Title != series.Title {
+			t.Errorf("occurrence %s is %q titled %q, want %q titled %q", occurrence.ID,
+				occurrence.Status, occurrence.Title, values.EventStatusPendingReview, series.Title)
+		}
+
+		var reviews int64
+		if err := db.Model(&models.EventReview{}).Where("event_id = ?", occurrence.ID).Count(&reviews).Error; err != nil {
+			t.Fatalf("counting reviews: %s", err)
+		}
+		if reviews != 1 {
+			t.Errorf("occurrence %s has %d reviews, want 1", occurrence.ID, reviews)
+		}
+	}
+}
+
+func TestUpdateSeriesMovesOccurrences(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	organizer := createTestUser(t, db, values.OrganizerRole)
+	repo := NewEventSeriesRepository(db)
+	series := createTestSeries(t, repo, organizer.ID.String())
+
+	var before []models.Event
+	if err := db.Where("series_id = ?", series.ID).Order("starts_at").Find(&before).Error; err != nil {
+		t.Fatalf("reading occurrences: %s", err)
+	}
+	if err := db.Model(&before[0]).Update("tickets_sold", 1).Error; err != nil {
+		t.Fatalf("selling a ticket: %s", err)
+	}
+
+	// The series moves to the next weekday, every occurrence follows by its position in the rule
+	series.StartsAt = series.StartsAt.Add(24 * time.Hour)
+	startTimes, err := series.OccurrencesBetween(time.Now(), time.Now().AddDate(0, 0, values.SeriesHorizonDays))
+	if err != nil {
+		t.Fatalf("OccurrencesBetween: %s", err)
+	}
+	if err := repo.UpdateSeries(ctx, series, startTimes, nil); err != nil {
+		t.Fatalf("UpdateSeries: %s", err)
+	}
+
+	var after []models.Event
+	if err := db.Unscoped().Where("series_id = ?", series.ID).Order("starts_at").Find(&after).Error; err != nil {
+		t.Fatalf("reading occurrences: %s", err)
+	}
+	if len(after) != len(before) {
+		t.Fatalf("%d occurrences after the move, want %d", len(after), len(before))
+	}
+	for i, occurrence := range after {
+		if occurrence.ID != before[i].ID || !occurrence.StartsAt.Equal(before[i].StartsAt.Add(24*time.Hour)) {
+			t.Errorf("occurrence %d is %s at %s, want %s at %s", i, occurrence.ID, occurrence.StartsAt,
+				before[i].ID, before[i].StartsAt.Add(24*time.Hour))
+		}
+	}
+}
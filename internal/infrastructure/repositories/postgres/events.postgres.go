@@ -4,6 +4,7 @@ package postgres
 import (
     "context"
     "errors"
+    "math"
     "time"
 
     "ticket-booking-app-backend/internal/domain/entities"
@@ -14,8 +15,15 @@ import (
     "github.com/google/uuid"
     "github.com/sirupsen/logrus"
     "gorm.io/gorm"
+    "gorm.io/gorm/clause"
 )
 
+// eventEditableColumns are the columns an organizer can change through UpdateEvent.
+var eventEditableColumns = []string{
+    "title", "description", "location", "venue_id", "category_id", "starts_at", "ends_at", "capacity", "price",
+    "reservation_ttl_minutes", "sales_cutoff_minutes", "refund_percent", "refund_deadline_hours",
+}
+
 type eventsRepository struct {
     db *gorm.DB
 }
@@ -33,24 +41,26 @@ func (r *eventsRepository) CreateEvent(ctx context.Context, event *entities.Even
         }
         
         gormEvent.OrganizerID = orgID
-        gormEvent.Status = values.EventStatusActive
+        // New events stay hidden until their organizer publishes them
+        gormEvent.Status = values.EventStatusDraft
         
         if err := tx.Create(gormEvent).Error; err != nil {
             return err
         }
         
-        return nil
+        return replaceEventTags(tx, gormEvent.ID, event.Tags)
     })
 }
 
 func (r *eventsRepository) GetEventByID(ctx context.Context, eventID string) (*entities.Event, error) {
-    // First update any expired events
-    if err := r.UpdateExpiredEvents(ctx); err != nil {
-        logrus.Errorf("Failed to update expired events: %v", err)
+    // First bring event statuses up to date
+    if err := r.UpdateEventStatuses(ctx); err != nil {
+        logrus.Errorf("Failed to update event statuses: %v", err)
     }
 
     var event models.Event
     err := r.db.WithContext(ctx).
+        Preload("Tags").
         Where("id = ?", eventID).
         First(&event).Error
         
@@ -64,50 +74,137 @@ func (r *eventsRepository) GetEventByID(ctx context.Context, eventID string) (*e
     return toDomainEvent(&event), nil
 }
 
-func (r *eventsRepository) GetEventsByOrganizer(ctx context.Context, status string, organizerID string) ([]*entities.Event, error) {
-    // First update any expired events
-    if err := r.UpdateExpiredEvents(ctx); err != nil {
-        logrus.Errorf("Failed to update expired events: %v", err)
+func (r *eventsRepository) GetEvents(ctx context.Context, filter *entities.EventFilter) ([]*entities.Event, int64, error) {
+    // First bring event statuses up to date
+    if err := r.UpdateEventStatuses(ctx); err != nil {
+        logrus.Errorf("Failed to update event statuses: %v", err)
+    }
+
+    var total int64
+    err := applyEventFilter(r.db.WithContext(ctx).Model(&models.Event{}), filter).
+        Count(&total).Error
+    if err != nil {
+        return nil, 0, err
     }
 
     var events []models.Event
-    query := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID)
-    
-    if status != "" {
-        query = query.Where("status = ?", status)
+    err = applyEventFilter(r.db.WithContext(ctx).Preload("Tags"), filter).
+        Order(eventOrder(filter)).
+        Limit(filter.Limit).
+        Offset(filter.Offset).
+        Find(&events).Error
+    if err != nil {
+        return nil, 0, err
     }
-    
-    if err := query.Find(&events).Error; err != nil {
+
+    return toDomainEvents(events), total, nil
+}
+
+func (r *eventsRepository) GetEventFacets(ctx context.Context, filter *entities.EventFilter) (*entities.EventFacets, error) {
+    facets := &entities.EventFacets{
+        Categories: []entities.FacetCount{},
+        Tags:       []entities.FacetCount{},
+    }
+
+    // Count every category, not only the selected one
+    categoryFilter := *filter
+    categoryFilter.CategoryID = ""
+
+    err := eventFacetQuery(r.db.WithContext(ctx), &categoryFilter).
+        Joins("JOIN categories ON categories.id = events.category_id AND categories.deleted_at IS NULL").
+        Select("categories.id::text AS value, categories.name AS label, COUNT(*) AS count").
+        Group("categories.id, categories.name").
+        Order("count DESC, label ASC").
+        Scan(&facets.Categories).Error
+    if err != nil {
+        return nil, err
+    }
+
+    err = eventFacetQuery(r.db.WithContext(ctx), filter).
+        Joins("JOIN event_tags ON event_tags.event_id = events.id").
+        Select("event_tags.tag AS value, COUNT(*) AS count").
+        Group("event_tags.tag").
+        Order("count DESC, value ASC").
+        Limit(values.MaxTagFacets).
+        Scan(&facets.Tags).Error
+    if err != nil {
         return nil, err
     }
 
-    return toDomainEvents(events), nil
+    return facets, nil
 }
 
-func (r *eventsRepository) GetEvents(ctx context.Context, status string) ([]*entities.Event, error) {
-    // First update any expired events
-    if err := r.UpdateExpiredEvents(ctx); err != nil {
-        logrus.Errorf("Failed to update expired events: %v", err)
+// haversineDistanceKm is the great-circle distance between the venue and a point, bound as
+// (earth radius, lat, lat, lng). LEAST guards ASIN against rounding just above 1
+const haversineDistanceKm = `2 * ? * ASIN(LEAST(1, SQRT(
+    POWER(SIN(RADIANS(venues.latitude - ?) / 2), 2) +
+    COS(RADIANS(?)) * COS(RADIANS(venues.latitude)) * POWER(SIN(RADIANS(venues.longitude - ?) / 2), 2))))`
+
+type nearbyEventRow struct {
+    models.Event `gorm:"embedded"`
+    DistanceKm   float64
+}
+
+func (r *eventsRepository) GetNearbyEvents(ctx context.Context, lat, lng, radiusKm float64, filter *entities.EventFilter) ([]*entities.NearbyEvent, int64, error) {
+    // First bring event statuses up to date
+    if err := r.UpdateEventStatuses(ctx); err != nil {
+        logrus.Errorf("Failed to update event statuses: %v", err)
     }
 
-    var events []models.Event
-    query := r.db.WithContext(ctx)
-    
-    if status != "" {
-        query = query.Where("status = ?", status)
+    // A latitude band lets the venues index skip most rows before the exact distance is computed
+    band := radiusKm / (values.EarthRadiusKm * math.Pi / 180)
+
+    nearby := func() *gorm.DB {
+        query := r.db.WithContext(ctx).
+            Model(&models.Event{}).
+            Joins("JOIN venues ON venues.id = events.venue_id AND venues.deleted_at IS NULL").
+            Where("venues.latitude BETWEEN ? AND ? AND venues.longitude IS NOT NULL", lat-band, lat+band).
+            Where(haversineDistanceKm+" <= ?", values.EarthRadiusKm, lat, lat, lng, radiusKm)
+        return applyEventFilter(query, filter)
     }
-    
-    if err := query.Find(&events).Error; err != nil {
-        return nil, err
+
+    var total int64
+    if err := nearby().Count(&total).Error; err != nil {
+        return nil, 0, err
+    }
+
+    var rows []nearbyEventRow
+    err := nearby().
+        Select("events.*, "+haversineDistanceKm+" AS distance_km", values.EarthRadiusKm, lat, lat, lng).
+        Order("distance_km ASC, events.id ASC").
+        Limit(filter.Limit).
+        Offset(filter.Offset).
+        Scan(&rows).Error
+    if err != nil {
+        return nil, 0, err
+    }
+
+    events := make([]*models.Event, len(rows))
+    for i := range rows {
+        events[i] = &rows[i].Event
+    }
+    if err := loadEventTags(r.db.WithContext(ctx), events); err != nil {
+        return nil, 0, err
     }
 
-    return toDomainEvents(events), nil
+    result := make([]*entities.NearbyEvent, len(rows))
+    for i := range rows {
+        result[i] = &entities.NearbyEvent{
+            Event:      toDomainEvent(&rows[i].Event),
+            DistanceKm: rows[i].DistanceKm,
+        }
+    }
+
+    return result, total, nil
 }
 
 func (r *eventsRepository) UpdateEvent(ctx context.Context, organizerID string, event *entities.Event) error {
     return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // The lock keeps reservations and tiers from taking places while the capacity changes
         var existingEvent models.Event
-        err := tx.Where("id = ? AND organizer_id = ?", event.ID, organizerID).
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+            Where("id = ?", event.ID).
+            Scopes(managedEventScope(organizerID)).
             First(&existingEvent).Error
             
         if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -118,44 +215,177 @@ func (r *eventsRepository) UpdateEvent(ctx context.Context, organizerID string,
         }
 
         // Don't allow updating if event is finished or cancelled
-        if existingEvent.Status == values.EventStatusFinished || 
-           existingEvent.Status == values.EventStatusCancelled {
+        if entities.IsFinalEventStatus(existingEvent.Status) {
             return errors.New("cannot update finished or cancelled event")
         }
+
+        if err := checkEventCapacity(tx, &existingEvent, event.Capacity); err != nil {
+            return err
+        }
         
-        return tx.Model(&existingEvent).Updates(toGormEvent(event)).Error
+        // Select the editable columns so zero values (e.g. disabling refunds) are saved too
+        if err := tx.Model(&existingEvent).
+            Select(eventEditableColumns).
+            Updates(toGormEvent(event)).Error; err != nil {
+            return err
+        }
+
+        return replaceEventTags(tx, existingEvent.ID, event.Tags)
     })
 }
 
-func (r *eventsRepository) UpdateEventStatus(ctx context.Context, eventID, organizerID, status string) error {
+// UpdateEventStatus moves the event from the status the caller validated the transition
+// from, an event that left it meanwhile is refused
+func (r *eventsRepository) UpdateEventStatus(ctx context.Context, eventID, organizerID, fromStatus, status string) error {
     result := r.db.WithContext(ctx).
         Model(&models.Event{}).
-        Where("id = ? AND organizer_id = ?", eventID, organizerID).
+        Where("id = ? AND status = ?", eventID, fromStatus).
+        Scopes(managedEventScope(organizerID)).
         Update("status", status)
         
     if result.Error != nil {
         return result.Error
     }
     if result.RowsAffected == 0 {
-        return types.ErrEventNotFound
+        return eventUpdateMissed(r.db.WithContext(ctx), eventID, organizerID)
+    }
+    return nil
+}
+
+// UpdateEventPublishing publishes or schedules the event if it's still in fromStatus
+func (r *eventsRepository) UpdateEventPublishing(ctx context.Context, eventID, fromStatus, status string, publishAt *time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&models.Event{}).
+        Where("id = ? AND status = ?", eventID, fromStatus).
+        Updates(map[string]interface{}{"status": status, "publish_at": publishAt})
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return eventUpdateMissed(r.db.WithContext(ctx), eventID, "")
     }
     return nil
 }
 
-func (r *eventsRepository) UpdateExpiredEvents(ctx context.Context) error {
+// CancelEvent cancels the event if it's still in the status the caller validated, and in the
+// same transaction releases its reservations and marks its paid tickets for a refund
+func (r *eventsRepository) CancelEvent(ctx context.Context, eventID, organizerID, status string) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // Lock the tickets before the event, the order expiry and payments take them in
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+            Where("event_id = ? AND status IN ?", eventID,
+                []string{values.TicketStatusReserved, values.TicketStatusPaid}).
+            Find(&[]models.Ticket{}).Error; err != nil {
+            return err
+        }
+
+        result := tx.Model(&models.Event{}).
+            Where("id = ? AND status = ?", eventID, status).
+            Scopes(managedEventScope(organizerID)).
+            Update("status", values.EventStatusCancelled)
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return eventUpdateMissed(tx, eventID, organizerID)
+        }
+
+        // Reservations made before the status changed are read again with the others
+        return cancelEventTickets(tx, eventID)
+    })
+}
+
+// PublishScheduledEvents publishes the scheduled events whose publish_at has come
+func (r *eventsRepository) PublishScheduledEvents(ctx context.Context) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Model(&models.Event{}).
+        Where("status = ? AND publish_at <= ?", values.EventStatusScheduled, time.Now()).
+        Updates(map[string]interface{}{"status": values.EventStatusPublished, "publish_at": nil})
+
+    if result.Error != nil {
+        return 0, result.Error
+    }
+    return result.RowsAffected, nil
+}
+
+// UpdateEventStatuses moves published events that have started to ongoing and
+// events that have ended to finished.
+func (r *eventsRepository) UpdateEventStatuses(ctx context.Context) error {
     return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        now := time.Now()
+
         result := tx.Model(&models.Event{}).
-            Where("date < ? AND status = ?", time.Now(), values.EventStatusActive).
+            Where("ends_at <= ? AND status IN ?", now,
+                []string{values.EventStatusPublished, values.EventStatusOngoing}).
             Update("status", values.EventStatusFinished)
         
         if result.Error != nil {
             return result.Error
         }
+
+        result = tx.Model(&models.Event{}).
+            Where("starts_at <= ? AND status = ?", now, values.EventStatusPublished).
+            Update("status", values.EventStatusOngoing)
+        
+        if result.Error != nil {
+            return result.Error
+        }
         
         return nil
     })
 }
 
+// RecalculateTicketsSold recomputes tickets_sold of every event and ticket type
+// from their reserved and paid tickets and returns how many counters had drifted.
+func (r *eventsRepository) RecalculateTicketsSold(ctx context.Context) (int64, error) {
+    var fixed int64
+
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // Keep reservations out while the counters are rebuilt
+        if err := tx.Exec("LOCK TABLE events, ticket_types IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
+            return err
+        }
+
+        held := tx.Model(&models.Ticket{}).
+            Select("COUNT(*)").
+            Where("tickets.event_id = events.id AND tickets.status IN ?",
+                []string{values.TicketStatusReserved, values.TicketStatusPaid})
+
+        result := tx.Model(&models.Event{}).
+            Where("tickets_sold <> (?)", held).
+            Update("tickets_sold", held)
+
+        if result.Error != nil {
+            return result.Error
+        }
+
+        fixed = result.RowsAffected
+
+        heldByType := tx.Model(&models.Ticket{}).
+            Select("COUNT(*)").
+            Where("tickets.ticket_type_id = ticket_types.id AND tickets.status IN ?",
+                []string{values.TicketStatusReserved, values.TicketStatusPaid})
+
+        result = tx.Model(&models.TicketType{}).
+            Where("tickets_sold <> (?)", heldByType).
+            Update("tickets_sold", heldByType)
+
+        if result.Error != nil {
+            return result.Error
+        }
+
+        fixed += result.RowsAffected
+        return nil
+    })
+
+    if err != nil {
+        return 0, err
+    }
+
+    return fixed, nil
+}
+
 func (r *eventsRepository) CheckEventCapacityIsFull(ctx context.Context, eventID string) (bool, error) {
     var event models.Event
     err := r.db.WithContext(ctx).
@@ -204,8 +434,11 @@ func (r *eventsRepository) IncrementTicketsSold(ctx context.Context, eventID str
 
 func (r *eventsRepository) DeleteEvent(ctx context.Context, eventID, organizerID string) error {
     return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // The lock keeps reservations out until the event is gone
         var event models.Event
-        err := tx.Where("id = ? AND organizer_id = ?", eventID, organizerID).
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+            Where("id = ?", eventID).
+            Scopes(managedEventScope(organizerID)).
             First(&event).Error
             
         if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -215,12 +448,132 @@ func (r *eventsRepository) DeleteEvent(ctx context.Context, eventID, organizerID
             return err
         }
 
+        // Sold or held places have to go through the cancellation, which refunds them
+        var tickets int64
+        if err := tx.Model(&models.Ticket{}).
+            Where("event_id = ? AND status IN ?", event.ID,
+                []string{values.TicketStatusReserved, values.TicketStatusPaid}).
+            Count(&tickets).Error; err != nil {
+            return err
+        }
+        if tickets > 0 {
+            return types.ErrEventHasTickets
+        }
+
         // Update status to cancelled instead of deleting
         return tx.Model(&event).Delete(&event).Error
     })
 }
 
 // Helper functions
+
+// eventSortColumns maps the sort fields of an event listing to their columns
+var eventSortColumns = map[string]string{
+    values.EventSortDate:      "events.starts_at",
+    values.EventSortPrice:     "events.price",
+    values.EventSortTitle:     "events.title",
+    values.EventSortCreatedAt: "events.created_at",
+}
+
+// applyEventFilter adds the filter conditions to the query, filter.Search is left to the search repository
+func applyEventFilter(query *gorm.DB, filter *entities.EventFilter) *gorm.DB {
+    if len(filter.Statuses) > 0 {
+        query = query.Where("events.status IN ?", filter.Statuses)
+    }
+    if filter.OrganizerID != "" {
+        query = query.Scopes(organizationScope("events", filter.OrganizerID, values.OrganizationPermissionViewEvents))
+    }
+    if filter.Location != "" {
+        query = query.Where("events.location ILIKE ?", containsPattern(filter.Location))
+    }
+    if filter.VenueID != "" {
+        query = query.Where("events.venue_id = ?", filter.VenueID)
+    }
+    if filter.CategoryID != "" {
+        query = query.Where("events.category_id = ?", filter.CategoryID)
+    }
+    for _, tag := range filter.Tags {
+        query = query.Where("EXISTS (SELECT 1 FROM event_tags WHERE event_tags.event_id = events.id AND event_tags.tag = ?)", tag)
+    }
+    if filter.DateFrom != nil {
+        query = query.Where("events.starts_at >= ?", *filter.DateFrom)
+    }
+    if filter.DateTo != nil {
+        query = query.Where("events.starts_at <= ?", *filter.DateTo)
+    }
+    if filter.PriceMin != nil {
+        query = query.Where("events.price >= ?", *filter.PriceMin)
+    }
+    if filter.PriceMax != nil {
+        query = query.Where("events.price <= ?", *filter.PriceMax)
+    }
+    return query
+}
+
+// eventOrder builds the ORDER BY of an event listing, the id keeps pages stable between equal values
+func eventOrder(filter *entities.EventFilter) string {
+    column, ok := eventSortColumns[filter.SortBy]
+    if !ok {
+        column = eventSortColumns[values.EventSortDate]
+    }
+
+    direction := "ASC"
+    if filter.SortDesc {
+        direction = "DESC"
+    }
+
+    return column + " " + direction + ", events.id " + direction
+}
+
+// eventFacetQuery selects the events of a listing, searched ones included, for counting facets
+func eventFacetQuery(db *gorm.DB, filter *entities.EventFilter) *gorm.DB {
+    query := applyEventFilter(db.Model(&models.Event{}), filter)
+    if filter.Search != "" {
+        query = applyEventSearch(query, filter.Search)
+    }
+    return query
+}
+
+// managedEventScope limits a query to the events the organizer may manage, all events when there's no organizer
+func managedEventScope(organizerID string) func(*gorm.DB) *gorm.DB {
+    if organizerID == "" {
+        return func(db *gorm.DB) *gorm.DB { return db }
+    }
+    return organizationScope("events", organizerID, values.OrganizationPermissionManageEvents)
+}
+
+// eventUpdateMissed explains why a conditional update of the event changed no row: the
+// event is gone or out of the organizer's reach, or it left the expected status meanwhile
+func eventUpdateMissed(tx *gorm.DB, eventID, organizerID string) error {
+    var count int64
+    if err := tx.Model(&models.Event{}).
+        Where("id = ?", eventID).
+        Scopes(managedEventScope(organizerID)).
+        Count(&count).Error; err != nil {
+        return err
+    }
+    if count == 0 {
+        return types.ErrEventNotFound
+    }
+    return types.ErrInvalidEventTransition
+}
+
+// replaceEventTags swaps the tags of an event for the given ones
+func replaceEventTags(tx *gorm.DB, eventID uuid.UUID, tags []string) error {
+    if err := tx.Where("event_id = ?", eventID).Delete(&models.EventTag{}).Error; err != nil {
+        return err
+    }
+    if len(tags) == 0 {
+        return nil
+    }
+
+    eventTags := make([]models.EventTag, len(tags))
+    for i, tag := range tags {
+        eventTags[i] = models.EventTag{EventID: eventID, Tag: tag}
+    }
+    return tx.Create(&eventTags).Error
+}
+
 func toDomainEvents(events []models.Event) []*entities.Event {
     result := make([]*entities.Event, len(events))
     for i, event := range events {
@@ -230,17 +583,41 @@ func toDomainEvents(events []models.Event) []*entities.Event {
 }
 
 func toDomainEvent(eventModel *models.Event) *entities.Event {
+    var seatMapID string
+    if eventModel.SeatMapID != nil {
+        seatMapID = eventModel.SeatMapID.String()
+    }
+
+    var tags []string
+    for _, tag := range eventModel.Tags {
+        tags = append(tags, tag.Tag)
+    }
+
     return &entities.Event{
         ID:          eventModel.ID.String(),
         Title:       eventModel.Title,
         Description: eventModel.Description,
         Location:    eventModel.Location,
-        Date:        eventModel.Date,
+        VenueID:     optionalId(eventModel.VenueID),
+        CategoryID:  optionalId(eventModel.CategoryID),
+        Tags:        tags,
+        StartsAt:    eventModel.StartsAt,
+        EndsAt:      eventModel.EndsAt,
         Capacity:    eventModel.Capacity,
         TicketsSold: eventModel.TicketsSold,
         Price:       eventModel.Price,
         Status:      eventModel.Status,
+        PublishAt:   eventModel.PublishAt,
+        SeatMapID:   seatMapID,
+        SeriesID:    optionalId(eventModel.SeriesID),
         CreatedAt:   eventModel.CreatedAt,
+
+        OrganizationID: optionalId(eventModel.OrganizationID),
+
+        ReservationTTLMinutes: eventModel.ReservationTTLMinutes,
+        SalesCutoffMinutes:    eventModel.SalesCutoffMinutes,
+        RefundPercent:         eventModel.RefundPercent,
+        RefundDeadlineHours:   eventModel.RefundDeadlineHours,
     }
 }
 
@@ -259,10 +636,20 @@ func toGormEvent(event *entities.Event) *models.Event {
         Title:       event.Title,
         Description: event.Description,
         Location:    event.Location,
-        Date:        event.Date,
+        VenueID:     optionalGormId(event.VenueID),
+        CategoryID:  optionalGormId(event.CategoryID),
+        StartsAt:    event.StartsAt,
+        EndsAt:      event.EndsAt,
         Capacity:    event.Capacity,
         TicketsSold: event.TicketsSold,
         Price:       event.Price,
         Status:      event.Status,
+
+        OrganizationID: optionalGormId(event.OrganizationID),
+
+        ReservationTTLMinutes: event.ReservationTTLMinutes,
+        SalesCutoffMinutes:    event.SalesCutoffMinutes,
+        RefundPercent:         event.RefundPercent,
+        RefundDeadlineHours:   event.RefundDeadlineHours,
     }
 }
\ No newline at end of file
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+)
+
+func TestCancelEventMarksPaidTicketsForRefund(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	user := createTestUser(t, db, values.UserRole)
+
+	ticketsRepo := NewTicketsRepository(db)
+	tickets, err := ticketsRepo.CreateTickets(ctx, event.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 2}})
+	if err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+
+	paymentsRepo := NewPaymentsRepository(db)
+	payment := &entities.Payment{ProviderPaymentID: uuid.NewString(), Amount: 10, Currency: values.PaymentCurrency}
+	if err := paymentsRepo.CreatePayment(ctx, user.ID.String(), []string{tickets[0].ID}, payment); err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+	webhookEvent := &entities.PaymentWebhookEvent{
+		ID:                uuid.NewString(),
+		Type:              payments.EventPaymentSucceeded,
+		ProviderPaymentID: payment.ProviderPaymentID,
+	}
+	if err := paymentsRepo.CompletePayment(ctx, payment.ID, time.Now(), webhookEvent); err != nil {
+		t.Fatalf("CompletePayment: %s", err)
+	}
+
+	repo := NewEventsRepository(db)
+	if err := repo.DeleteEvent(ctx, event.ID.String(), ""); !errors.Is(err, types.ErrEventHasTickets) {
+		t.Fatalf("DeleteEvent error = %v, want %v", err, types.ErrEventHasTickets)
+	}
+
+	// A cancellation validated against an outdated status changes nothing
+	err = repo.CancelEvent(ctx, event.ID.String(), "", values.EventStatusDraft)
+	if !errors.Is(err, types.ErrInvalidEventTransition) {
+		t.Fatalf("CancelEvent error = %v, want %v", err, types.ErrInvalidEventTransition)
+	}
+
+	if err := repo.CancelEvent(ctx, event.ID.String(), "", values.EventStatusPublished); err != nil {
+		t.Fatalf("CancelEvent: %s", err)
+	}
+
+	var stored models.Event
+	if err := db.First(&stored, "id = ?", event.ID).Error; err != nil {
+		t.Fatalf("reading event: %s", err)
+	}
+	if stored.Status != values.EventStatusCancelled || stored.TicketsSold != 1 {
+		t.Errorf("event status = %q with %d sold, want %q with 1", stored.Status, stored.TicketsSold, values.EventStatusCancelled)
+	}
+
+	var reserved models.Ticket
+	if err := db.First(&reserved, "id = ?", tickets[1].ID).Error; err != nil {
+		t.Fatalf("reading ticket: %s", err)
+	}
+	if reserved.Status != values.TicketStatusCancelled {
+		t.Errorf("reserved ticket status = %q, want %q", reserved.Status, values.TicketStatusCancelled)
+	}
+
+	pending, err := ticketsRepo.GetRefundPendingTickets(ctx, event.ID.String())
+	if err != nil {
+		t.Fatalf("GetRefundPendingTickets: %s", err)
+	}
+	if len(pending) != 1 || pending[0].ID != tickets[0].ID {
+		t.Errorf("refund pending tickets = %v, want the paid ticket %s", pending, tickets[0].ID)
+	}
+}
+
+func TestUpdateEventPublishingFromOutdatedStatus(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+
+	// Two publish requests validated the draft event, the first one already published it
+	repo := NewEventsRepository(db)
+	err := repo.UpdateEventPublishing(ctx, event.ID.String(), values.EventStatusDraft, values.EventStatusPublished, nil)
+	if !errors.Is(err, types.ErrInvalidEventTransition) {
+		t.Fatalf("UpdateEventPublishing error = %v, want %v", err, types.ErrInvalidEventTransition)
+	}
+
+	err = repo.UpdateEventStatus(ctx, uuid.NewString(), "", values.EventStatusPublished, values.EventStatusCancelled)
+	if !errors.Is(err, types.ErrEventNotFound) {
+		t.Errorf("UpdateEventStatus of a missing event error = %v, want %v", err, types.ErrEventNotFound)
+	}
+}
+
+func TestSaveReviewOfDecidedEvent(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	if err := db.Model(event).Update("status", values.EventStatusPendingReview).Error; err != nil {
+		t.Fatalf("submitting event: %s", err)
+	}
+
+	// Two reviewers decide on the event at the same time
+	repo := NewEventReviewsRepository(db)
+	approval := &entities.EventReview{EventID: event.ID.String(), ReviewerID: event.OrganizerID.String(), Action: values.ReviewActionApproved}
+	if err := repo.SaveReview(ctx, approval, values.EventStatusPendingReview, values.EventStatusPublished, nil); err != nil {
+		t.Fatalf("SaveReview: %s", err)
+	}
+	rejection := &entities.EventReview{EventID: event.ID.String(), ReviewerID: event.OrganizerID.String(), Action: values.ReviewActionRejected}
+	err := repo.SaveReview(ctx, rejection, values.EventStatusPendingReview, values.EventStatusDraft, nil)
+	if !errors.Is(err, types.ErrEventNotPendingReview) {
+		t.Fatalf("second SaveReview error = %v, want %v", err, types.ErrEventNotPendingReview)
+	}
+
+	var stored models.Event
+	if err := db.First(&stored, "id = ?", event.ID).Error; err != nil {
+		t.Fatalf("reading event: %s", err)
+	}
+	if stored.Status != values.EventStatusPublished {
+		t.Errorf("event status = %q, want %q", stored.Status, values.EventStatusPublished)
+	}
+}
+
+func TestUpdateEventCapacityKeepsSoldAndAllocatedPlaces(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	user := createTestUser(t, db, values.UserRole)
+
+	if _, err := NewTicketsRepository(db).CreateTickets(ctx, event.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 3}}); err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+	if err := db.Create(&models.TicketType{EventID: event.ID, Name: "VIP", Price: 20, Capacity: 5}).Error; err != nil {
+		t.Fatalf("creating ticket type: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		capacity int
+		wantErr  error
+	}{
+		{"below the sold tickets", 2, types.ErrEventCapacityBelowSold},
+		{"below the tiers", 4, types.ErrTicketTypeCapacityExceeded},
+		{"holding both", 5, nil},
+	}
+
+	repo := NewEventsRepository(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored models.Event
+			if err := db.First(&stored, "id = ?", event.ID).Error; err != nil {
+				t.Fatalf("reading event: %s", err)
+			}
+			update := toDomainEvent(&stored)
+			update.Capacity = tt.capacity
+
+			if err := repo.UpdateEvent(ctx, "", update); !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateEvent error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// infrastructure/repositories/postgres/organizations.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type organizationsRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationsRepository(db *gorm.DB) *organizationsRepository {
+	return &organizationsRepository{db: db}
+}
+
+// organizationMemberRow is a member with the user details shown in member lists
+type organizationMemberRow struct {
+	models.OrganizationMember `gorm:"embedded"`
+	Name                      string
+	Email                     string
+}
+
+// organizationRow is an organization with the role of the user it was listed for
+type organizationRow struct {
+	models.Organization `gorm:"embedded"`
+	MemberRole          string
+}
+
+// Create operations
+
+func (r *organizationsRepository) CreateOrganization(ctx context.Context, organization *entities.Organization) error {
+	ownerID, err := validateGormId(organization.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gormOrganization := &models.Organization{
+			Name:    organization.Name,
+			OwnerID: ownerID,
+		}
+		if err := tx.Create(gormOrganization).Error; err != nil {
+			return err
+		}
+
+		owner := &models.OrganizationMember{
+			OrganizationID: gormOrganization.ID,
+			UserID:         ownerID,
+			Role:           values.OrganizationRoleOwner,
+		}
+		if err := tx.Create(owner).Error; err != nil {
+			return err
+		}
+
+		*organization = *toDomainOrganization(gormOrganization)
+		organization.Role = values.OrganizationRoleOwner
+		return nil
+	})
+}
+
+func (r *organizationsRepository) CreateInvitation(ctx context.Context, invitation *entities.OrganizationInvitation) error {
+	gormInvitation, err := toGormInvitation(invitation)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Create(gormInvitation).Error; err != nil {
+		return err
+	}
+
+	token := invitation.Token
+	*invitation = *toDomainInvitation(gormInvitation)
+	invitation.Token = token
+	return nil
+}
+
+func (r *organizationsRepository) AcceptInvitation(ctx context.Context, invitationID, userID string) error {
+	memberID, err := validateGormId(userID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invitation models.OrganizationInvitation
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", invitationID).
+			First(&invitation).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrInvitationNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if invitation.AcceptedAt != nil || !now.Before(invitation.ExpiresAt) {
+			return types.ErrInvitationExpired
+		}
+
+		var count int64
+		if err := tx.Model(&models.OrganizationMember{}).
+			Where("organization_id = ? AND user_id = ?", invitation.OrganizationID, memberID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrOrganizationMemberExists
+		}
+
+		member := &models.OrganizationMember{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         memberID,
+			Role:           invitation.Role,
+		}
+		if err := tx.Create(member).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&invitation).Update("accepted_at", now).Error
+	})
+}
+
+// Read operations
+
+func (r *organizationsRepository) GetOrganizationByID(ctx context.Context, organizationID string) (*entities.Organization, error) {
+	var organization models.Organization
+	err := r.db.WithContext(ctx).
+		Where("id = ?", organizationID).
+		First(&organization).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []organizationMemberRow
+	if err := r.db.WithContext(ctx).
+		Model(&models.OrganizationMember{}).
+		Select("organization_members.*, users.name, users.email").
+		Joins("JOIN users ON users.id = organization_members.user_id").
+		Where("organization_members.organization_id = ?", organization.ID).
+		Order("organization_members.created_at ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := toDomainOrganization(&organization)
+	result.Members = make([]*entities.OrganizationMember, len(rows))
+	for i := range rows {
+		result.Members[i] = toDomainMember(&rows[i])
+	}
+	return result, nil
+}
+
+func (r *organizationsRepository) GetOrganizationsByUser(ctx context.Context, userID string) ([]*entities.Organization, error) {
+	var rows []organizationRow
+	if err := r.db.WithContext(ctx).
+		Model(&models.Organization{}).
+		Select("organizations.*, organization_members.role AS member_role").
+		Joins("JOIN organization_members ON organization_members.organization_id = organizations.id").
+		Where("organization_members.user_id = ?", userID).
+		Order("organizations.created_at ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.Organization, len(rows))
+	for i := range rows {
+		result[i] = toDomainOrganization(&rows[i].Organization)
+		result[i].Role = rows[i].MemberRole
+	}
+	return result, nil
+}
+
+func (r *organizationsRepository) GetDefaultOrganization(ctx context.Context, userID string) (*entities.Organization, error) {
+	var organization models.Organization
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ?", userID).
+		Order("created_at ASC").
+		First(&organization).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOrganization(&organization), nil
+}
+
+func (r *organizationsRepository) GetMember(ctx context.Context, organizationID, userID string) (*entities.OrganizationMember, error) {
+	var row organizationMemberRow
+	err := r.db.WithContext(ctx).
+		Model(&models.OrganizationMember{}).
+		Select("organization_members.*, users.name, users.email").
+		Joins("JOIN users ON users.id = organization_members.user_id").
+		Where("organization_members.organization_id = ? AND organization_members.user_id = ?", organizationID, userID).
+		Take(&row).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrOrganizationMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainMember(&row), nil
+}
+
+func (r *organizationsRepository) GetInvitationByToken(ctx context.Context, tokenHash string) (*entities.OrganizationInvitation, error) {
+	var invitation models.OrganizationInvitation
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		First(&invitation).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainInvitation(&invitation), nil
+}
+
+func (r *organizationsRepository) GetPendingInvitations(ctx context.Context, organizationID string) ([]*entities.OrganizationInvitation, error) {
+	var invitations []models.OrganizationInvitation
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND accepted_at IS NULL AND expires_at > ?", organizationID, time.Now()).
+		Order("created_at ASC").
+		Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.OrganizationInvitation, len(invitations))
+	for i := range invitations {
+		result[i] = toDomainInvitation(&invitations[i])
+	}
+	return result, nil
+}
+
+// Update operations
+
+func (r *organizationsRepository) UpdateMemberRole(ctx context.Context, organizationID, userID, role string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Update("role", role)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+// Delete operations
+
+func (r *organizationsRepository) RemoveMember(ctx context.Context, organizationID, userID string) error {
+	result := r.db.WithContext(ctx).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Delete(&models.OrganizationMember{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainOrganization(organizationModel *models.Organization) *entities.Organization {
+	return &entities.Organization{
+		ID:        organizationModel.ID.String(),
+		Name:      organizationModel.Name,
+		OwnerID:   organizationModel.OwnerID.String(),
+		CreatedAt: organizationModel.CreatedAt,
+	}
+}
+
+func toDomainMember(row *organizationMemberRow) *entities.OrganizationMember {
+	return &entities.OrganizationMember{
+		OrganizationID: row.OrganizationID.String(),
+		UserID:         row.UserID.String(),
+		Name:           row.Name,
+		Email:          row.Email,
+		Role:           row.Role,
+		JoinedAt:       row.CreatedAt,
+	}
+}
+
+func toDomainInvitation(invitationModel *models.OrganizationInvitation) *entities.OrganizationInvitation {
+	return &entities.OrganizationInvitation{
+		ID:             invitationModel.ID.String(),
+		OrganizationID: invitationModel.OrganizationID.String(),
+		Email:          invitationModel.Email,
+		Role:           invitationModel.Role,
+		InvitedBy:      invitationModel.InvitedBy.String(),
+		TokenHash:      invitationModel.TokenHash,
+		ExpiresAt:      invitationModel.ExpiresAt,
+		AcceptedAt:     invitationModel.AcceptedAt,
+		CreatedAt:      invitationModel.CreatedAt,
+	}
+}
+
+func toGormInvitation(invitation *entities.OrganizationInvitation) (*models.OrganizationInvitation, error) {
+	organizationID, err := validateGormId(invitation.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitedBy, err := validateGormId(invitation.InvitedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OrganizationInvitation{
+		OrganizationID: organizationID,
+		Email:          invitation.Email,
+		Role:           invitation.Role,
+		InvitedBy:      invitedBy,
+		TokenHash:      invitation.TokenHash,
+		ExpiresAt:      invitation.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// infrastructure/repositories/postgres/password_resets.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type passwordResetsRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetsRepository(db *gorm.DB) *passwordResetsRepository {
+	return &passwordResetsRepository{db: db}
+}
+
+// Create operations
+
+func (r *passwordResetsRepository) CreatePasswordReset(ctx context.Context, reset *entities.PasswordReset) error {
+	userID, err := validateGormId(reset.UserID)
+	if err != nil {
+		return err
+	}
+
+	gormReset := &models.PasswordReset{
+		UserID:    userID,
+		TokenHash: reset.TokenHash,
+		ExpiresAt: reset.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(gormReset).Error; err != nil {
+		return err
+	}
+
+	reset.ID = gormReset.ID.String()
+	reset.CreatedAt = gormReset.CreatedAt
+	return nil
+}
+
+// Update operations
+
+func (r *passwordResetsRepository) ResetPassword(ctx context.Context, tokenHash, passwordHash string) (string, error) {
+	var userID string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var reset models.PasswordReset
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", tokenHash).
+			First(&reset).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrPasswordResetTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if reset.UsedAt != nil || !now.Before(reset.ExpiresAt) {
+			return types.ErrPasswordResetTokenInvalid
+		}
+
+		result := tx.Model(&models.User{}).
+			Where("id = ?", reset.UserID).
+			Update("password", passwordHash)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrPasswordResetTokenInvalid
+		}
+
+		// Other tokens mailed to the user stop working as well
+		if err := tx.Model(&models.PasswordReset{}).
+			Where("user_id = ? AND used_at IS NULL", reset.UserID).
+			Update("used_at", now).Error; err != nil {
+			return err
+		}
+
+		userID = reset.UserID.String()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,378 @@
+// infrastructure/repositories/postgres/payments.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentsRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentsRepository(db *gorm.DB) *paymentsRepository {
+	return &paymentsRepository{db: db}
+}
+
+func (r *paymentsRepository) CreatePayment(ctx context.Context, userID string, ticketIDs []string, payment *entities.Payment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userUUID, err := validateGormId(userID)
+		if err != nil {
+			return err
+		}
+
+		gormPayment := &models.Payment{
+			UserID:            userUUID,
+			ProviderPaymentID: optionalString(payment.ProviderPaymentID),
+			CheckoutURL:       payment.CheckoutURL,
+			Amount:            payment.Amount,
+			Currency:          payment.Currency,
+			Status:            values.PaymentStatusPending,
+		}
+
+		if err := tx.Create(gormPayment).Error; err != nil {
+			return err
+		}
+
+		// Attach the tickets, making sure none of them was paid or picked up
+		// by another checkout in the meantime
+		result := tx.Model(&models.Ticket{}).
+			Where("id IN ? AND user_id = ? AND status = ? AND payment_id IS NULL", ticketIDs, userID, values.TicketStatusReserved).
+			Update("payment_id", gormPayment.ID)
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(ticketIDs)) {
+			return types.ErrTicketNotPayable
+		}
+
+		var tickets []models.Ticket
+		if err := tx.Where("payment_id = ?", gormPayment.ID).Find(&tickets).Error; err != nil {
+			return err
+		}
+		gormPayment.Tickets = tickets
+
+		*payment = *toDomainPayment(gormPayment)
+		return nil
+	})
+}
+
+// AttachProviderPayment records the provider payment created for the checkout. It's recorded
+// whatever the status of the payment, so a charge that still comes in is matched and refunded.
+func (r *paymentsRepository) AttachProviderPayment(ctx context.Context, paymentID, providerPaymentID, checkoutURL string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.Payment{}).
+		Where("id = ? AND provider_payment_id IS NULL", paymentID).
+		Updates(map[string]interface{}{
+			"provider_payment_id": providerPaymentID,
+			"checkout_url":        checkoutURL,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrPaymentNotFound
+	}
+	return nil
+}
+
+func (r *paymentsRepository) GetPaymentByID(ctx context.Context, paymentID string) (*entities.Payment, error) {
+	var payment models.Payment
+	err := r.db.WithContext(ctx).
+		Preload("Tickets").
+		Where("id = ?", paymentID).
+		First(&payment).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPayment(&payment), nil
+}
+
+func (r *paymentsRepository) GetPaymentsByUser(ctx context.Context, userID, status string) ([]*entities.Payment, error) {
+	var payments []models.Payment
+	query := r.db.WithContext(ctx).
+		Preload("Tickets").
+		Where("user_id = ?", userID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Order("created_at DESC").Find(&payments).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainPayments(payments), nil
+}
+
+func (r *paymentsRepository) GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entities.Payment, error) {
+	var payment models.Payment
+	err := r.db.WithContext(ctx).
+		Preload("Tickets").
+		Where("provider_payment_id = ?", providerPaymentID).
+		First(&payment).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPayment(&payment), nil
+}
+
+func (r *paymentsRepository) CompletePayment(ctx context.Context, paymentID string, paidAt time.Time, event *entities.PaymentWebhookEvent) error {
+	released := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		payment, tickets, err := lockPayment(tx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		switch payment.Status {
+		case values.PaymentStatusCompleted:
+			return recordWebhookEvent(tx, event)
+		case values.PaymentStatusPending:
+		default:
+			released = true
+			return nil
+		}
+
+		// A ticket that expired or was cancelled during the checkout gave its place back,
+		// which may be sold already. The failure is committed before it's reported.
+		for _, ticket := range tickets {
+			if ticket.Status != values.TicketStatusReserved {
+				released = true
+				return failPayments(tx, []uuid.UUID{payment.ID})
+			}
+		}
+
+		if err := recordWebhookEvent(tx, event); err != nil {
+			return err
+		}
+
+		if err := tx.Model(payment).
+			Update("status", values.PaymentStatusCompleted).Error; err != nil {
+			return err
+		}
+
+		for _, ticket := range tickets {
+			if err := updateTicketPayment(tx, ticket.ID.String(), paidAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if released {
+		return types.ErrPaymentReleased
+	}
+	return nil
+}
+
+func (r *paymentsRepository) FailPayment(ctx context.Context, paymentID string, event *entities.PaymentWebhookEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		payment, _, err := lockPayment(tx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		if err := recordWebhookEvent(tx, event); err != nil {
+			return err
+		}
+
+		return failPayments(tx, []uuid.UUID{payment.ID})
+	})
+}
+
+func (r *paymentsRepository) RefundPayment(ctx context.Context, paymentID string, refund *entities.ProviderRefund, event *entities.PaymentWebhookEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		payment, _, err := lockPayment(tx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		if err := recordWebhookEvent(tx, event); err != nil {
+			return err
+		}
+
+		// Nothing was charged yet
+		if payment.Status == values.PaymentStatusPending {
+			return nil
+		}
+
+		// The callback of a ticket refund can come in before the refund was marked completed
+		if refundID := optionalGormId(refund.RefundID); refundID != nil {
+			var ticketRefund models.Refund
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ? AND payment_id = ?", *refundID, payment.ID).
+				First(&ticketRefund).Error
+
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+			case err != nil:
+				return err
+			case ticketRefund.Status != values.RefundStatusCompleted:
+				if err := completeRefund(tx, &ticketRefund, refund.ID); err != nil {
+					return err
+				}
+			}
+		}
+
+		if payment.Status == values.PaymentStatusRefunded || !coversAmount(refund.RefundedTotal, payment.Amount) {
+			return nil
+		}
+
+		// The whole charge was given back, none of its tickets stays paid
+		if err := tx.Model(payment).
+			Update("status", values.PaymentStatusRefunded).Error; err != nil {
+			return err
+		}
+
+		var paid []models.Ticket
+		if err := tx.Where("payment_id = ? AND status = ?", payment.ID, values.TicketStatusPaid).
+			Find(&paid).Error; err != nil {
+			return err
+		}
+		if len(paid) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&paid).
+			Updates(map[string]interface{}{"status": values.TicketStatusRefunded, "refund_pending": false}).Error; err != nil {
+			return err
+		}
+
+		return releaseEventCapacity(tx, paid)
+	})
+}
+
+func (r *paymentsRepository) SaveWebhookEvent(ctx context.Context, event *entities.PaymentWebhookEvent) error {
+	return recordWebhookEvent(r.db.WithContext(ctx), event)
+}
+
+func (r *paymentsRepository) ValidatePaymentOwnership(ctx context.Context, paymentID, userID string) error {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Payment{}).
+		Where("id = ? AND user_id = ?", paymentID, userID).
+		Count(&count).Error
+
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrPaymentNotFound
+	}
+	return nil
+}
+
+// lockPayment locks the payment and the tickets it covers. The tickets are locked first,
+// in the order ticket expiry and event cancellation lock them.
+func lockPayment(tx *gorm.DB, paymentID string) (*models.Payment, []models.Ticket, error) {
+	var tickets []models.Ticket
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("payment_id = ?", paymentID).
+		Find(&tickets).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var payment models.Payment
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", paymentID).
+		First(&payment).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, types.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &payment, tickets, nil
+}
+
+// recordWebhookEvent records a processed webhook event. A delivery of the same event
+// running concurrently waits on the insert, and finds it recorded once the first commits.
+func recordWebhookEvent(tx *gorm.DB, event *entities.PaymentWebhookEvent) error {
+	if event == nil {
+		return nil
+	}
+
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.PaymentWebhookEvent{
+			ID:                event.ID,
+			Type:              event.Type,
+			ProviderPaymentID: event.ProviderPaymentID,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrWebhookProcessed
+	}
+	return nil
+}
+
+// coversAmount tells whether the refunded total reaches the amount, to the cent
+func coversAmount(refunded, amount float64) bool {
+	return math.Round(refunded*100) >= math.Round(amount*100)
+}
+
+// failPayments fails the payments that are still pending and detaches their reserved
+// tickets, so they can be checked out again
+func failPayments(tx *gorm.DB, paymentIDs []uuid.UUID) error {
+	if err := tx.Model(&models.Payment{}).
+		Where("id IN ? AND status = ?", paymentIDs, values.PaymentStatusPending).
+		Update("status", values.PaymentStatusFailed).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&models.Ticket{}).
+		Where("payment_id IN ? AND status = ?", paymentIDs, values.TicketStatusReserved).
+		Update("payment_id", nil).Error
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainPayments(payments []models.Payment) []*entities.Payment {
+	result := make([]*entities.Payment, len(payments))
+	for i, payment := range payments {
+		result[i] = toDomainPayment(&payment)
+	}
+	return result
+}
+
+func toDomainPayment(paymentModel *models.Payment) *entities.Payment {
+	return &entities.Payment{
+		ID:                paymentModel.ID.String(),
+		UserID:            paymentModel.UserID.String(),
+		ProviderPaymentID: stringValue(paymentModel.ProviderPaymentID),
+		CheckoutURL:       paymentModel.CheckoutURL,
+		Amount:            paymentModel.Amount,
+		Currency:          paymentModel.Currency,
+		Status:            paymentModel.Status,
+		Tickets:           toDomainTickets(paymentModel.Tickets),
+		CreatedAt:         paymentModel.CreatedAt,
+	}
+}
@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+)
+
+func TestCompletePaymentAppliesWebhookEventOnce(t *testing.T) {
+	const deliveries = 20
+
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	user := createTestUser(t, db, values.UserRole)
+
+	tickets, err := NewTicketsRepository(db).CreateTickets(ctx, event.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 2}})
+	if err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+
+	repo := NewPaymentsRepository(db)
+	payment := &entities.Payment{ProviderPaymentID: uuid.NewString(), Amount: 20, Currency: values.PaymentCurrency}
+	if err := repo.CreatePayment(ctx, user.ID.String(), []string{tickets[0].ID, tickets[1].ID}, payment); err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+
+	// The provider retries the same callback while the first delivery is still running
+	webhookEvent := &entities.PaymentWebhookEvent{
+		ID:                uuid.NewString(),
+		Type:              payments.EventPaymentSucceeded,
+		ProviderPaymentID: payment.ProviderPaymentID,
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		applied    int
+		duplicates int
+	)
+	start := make(chan struct{})
+	for i := 0; i < deliveries; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			err := repo.CompletePayment(ctx, payment.ID, time.Now(), webhookEvent)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				applied++
+			case errors.Is(err, types.ErrWebhookProcessed):
+				duplicates++
+			default:
+				t.Errorf("unexpected CompletePayment error: %s", err)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if applied != 1 || duplicates != deliveries-1 {
+		t.Errorf("%d deliveries applied and %d duplicates, want 1 and %d", applied, duplicates, deliveries-1)
+	}
+
+	stored, err := repo.GetPaymentByID(ctx, payment.ID)
+	if err != nil {
+		t.Fatalf("GetPaymentByID: %s", err)
+	}
+	if stored.Status != values.PaymentStatusCompleted {
+		t.Errorf("payment status = %q, want %q", stored.Status, values.PaymentStatusCompleted)
+	}
+	for _, ticket := range stored.Tickets {
+		if ticket.Status != values.TicketStatusPaid {
+			t.Errorf("ticket %s status = %q, want %q", ticket.ID, ticket.Status, values.TicketStatusPaid)
+		}
+	}
+}
+
+func TestCompletePaymentOfCancelledTickets(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	user := createTestUser(t, db, values.UserRole)
+
+	ticketsRepo := NewTicketsRepository(db)
+	tickets, err := ticketsRepo.CreateTickets(ctx, event.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 1}})
+	if err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+
+	repo := NewPaymentsRepository(db)
+	payment := &entities.Payment{ProviderPaymentID: uuid.NewString(), Amount: 10, Currency: values.PaymentCurrency}
+	if err := repo.CreatePayment(ctx, user.ID.String(), []string{tickets[0].ID}, payment); err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+
+	// The event is cancelled while the customer is on the checkout page
+	if err := ticketsRepo.CancelEventTickets(ctx, event.ID.String()); err != nil {
+		t.Fatalf("CancelEventTickets: %s", err)
+	}
+
+	webhookEvent := &entities.PaymentWebhookEvent{
+		ID:                uuid.NewString(),
+		Type:              payments.EventPaymentSucceeded,
+		ProviderPaymentID: payment.ProviderPaymentID,
+	}
+	if err := repo.CompletePayment(ctx, payment.ID, time.Now(), webhookEvent); !errors.Is(err, types.ErrPaymentReleased) {
+		t.Fatalf("CompletePayment error = %v, want %v", err, types.ErrPaymentReleased)
+	}
+
+	var ticket models.Ticket
+	if err := db.First(&ticket, "id = ?", tickets[0].ID).Error; err != nil {
+		t.Fatalf("reading ticket: %s", err)
+	}
+	if ticket.Status == values.TicketStatusPaid {
+		t.Error("ticket of a cancelled event was paid")
+	}
+
+	// The event is left for the caller to record once the charge is given back
+	if err := repo.SaveWebhookEvent(ctx, webhookEvent); err != nil {
+		t.Errorf("SaveWebhookEvent: %s", err)
+	}
+}
+
+func TestRefundPaymentAppliesOnlyItsRefund(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 10)
+	user := createTestUser(t, db, values.UserRole)
+
+	tickets, err := NewTicketsRepository(db).CreateTickets(ctx, event.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 3}})
+	if err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+
+	repo := NewPaymentsRepository(db)
+	payment := &entities.Payment{ProviderPaymentID: uuid.NewString(), Amount: 30, Currency: values.PaymentCurrency}
+	if err := repo.CreatePayment(ctx, user.ID.String(), []string{tickets[0].ID, tickets[1].ID, tickets[2].ID}, payment); err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+	if err := repo.CompletePayment(ctx, payment.ID, time.Now(), nil); err != nil {
+		t.Fatalf("CompletePayment: %s", err)
+	}
+
+	refund := &entities.Refund{TicketID: tickets[0].ID, PaymentID: payment.ID, EventID: event.ID.String(),
+		UserID: user.ID.String(), Amount: 10, Reason: values.RefundReasonUserRequested}
+	if err := NewRefundsRepository(db).CreateRefund(ctx, refund); err != nil {
+		t.Fatalf("CreateRefund: %s", err)
+	}
+
+	steps := []struct {
+		name        string
+		refund      *entities.ProviderRefund
+		wantPayment string
+		wantTickets []string
+	}{
+		{
+			name:        "the callback of one ticket's refund",
+			refund:      &entities.ProviderRefund{ID: uuid.NewString(), RefundID: refund.ID, Amount: 10, RefundedTotal: 10},
+			wantPayment: values.PaymentStatusCompleted,
+			wantTickets: []string{values.TicketStatusRefunded, values.TicketStatusPaid, values.TicketStatusPaid},
+		},
+		{
+			name:        "the rest refunded at the provider",
+			refund:      &entities.ProviderRefund{ID: uuid.NewString(), Amount: 20, RefundedTotal: 30},
+			wantPayment: values.PaymentStatusRefunded,
+			wantTickets: []string{values.TicketStatusRefunded, values.TicketStatusRefunded, values.TicketStatusRefunded},
+		},
+	}
+
+	for _, step := range steps {
+		webhookEvent := &entities.PaymentWebhookEvent{
+			ID:                uuid.NewString(),
+			Type:              payments.EventPaymentRefunded,
+			ProviderPaymentID: payment.ProviderPaymentID,
+		}
+		if err := repo.RefundPayment(ctx, payment.ID, step.refund, webhookEvent); err != nil {
+			t.Fatalf("%s: RefundPayment: %s", step.name, err)
+		}
+
+		stored, err := repo.GetPaymentByID(ctx, payment.ID)
+		if err != nil {
+			t.Fatalf("GetPaymentByID: %s", err)
+		}
+		if stored.Status != step.wantPayment {
+			t.Errorf("%s: payment status = %q, want %q", step.name, stored.Status, step.wantPayment)
+		}
+		for i, ticket := range tickets {
+			var stored models.Ticket
+			if err := db.First(&stored, "id = ?", ticket.ID).Error; err != nil {
+				t.Fatalf("reading ticket: %s", err)
+			}
+			if stored.Status != step.wantTickets[i] {
+				t.Errorf("%s: ticket %d status = %q, want %q", step.name, i, stored.Status, step.wantTickets[i])
+			}
+		}
+	}
+
+	var sold models.Event
+	if err := db.First(&sold, "id = ?", event.ID).Error; err != nil {
+		t.Fatalf("reading event: %s", err)
+	}
+	if sold.TicketsSold != 0 {
+		t.Errorf("tickets_sold = %d after every ticket was refunded, want 0", sold.TicketsSold)
+	}
+}
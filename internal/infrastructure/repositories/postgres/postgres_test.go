@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	connection "ticket-booking-app-backend/internal/infrastructure/drivers/postgres/connection"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	gormPostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDSNKey names the database the repository tests run against. They migrate it and
+// leave the rows they create behind, so it shouldn't hold anything else.
+const testDSNKey = "TEST_DB_DSN"
+
+var (
+	testDBOnce sync.Once
+	testDBConn *gorm.DB
+	testDBErr  error
+)
+
+// testDB returns the migrated test database, the test is skipped when none is configured
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNKey)
+	if dsn == "" {
+		t.Skipf("%s isn't set, skipping the Postgres tests", testDSNKey)
+	}
+
+	testDBOnce.Do(func() {
+		testDBConn, testDBErr = gorm.Open(gormPostgres.Open(dsn), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		if testDBErr != nil {
+			return
+		}
+
+		// Parallel tests would otherwise open more connections than the server allows
+		sqlDB, err := testDBConn.DB()
+		if err != nil {
+			testDBErr = err
+			return
+		}
+		sqlDB.SetMaxOpenConns(20)
+
+		testDBErr = connection.Migrate(testDBConn, "../../drivers/postgres/setup")
+	})
+	if testDBErr != nil {
+		t.Fatalf("preparing the test database: %s", testDBErr)
+	}
+
+	return testDBConn
+}
+
+func createTestUser(t *testing.T, db *gorm.DB, role string) *models.User {
+	t.Helper()
+
+	user := &models.User{
+		Email:    uuid.NewString() + "@test.local",
+		Password: "not-a-hash",
+		Name:     "Test " + role,
+		Role:     role,
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("creating user: %s", err)
+	}
+	return user
+}
+
+// createTestEvent creates a published event on sale for the next day
+func createTestEvent(t *testing.T, db *gorm.DB, capacity int) *models.Event {
+	t.Helper()
+
+	organizer := createTestUser(t, db, values.OrganizerRole)
+	startsAt := time.Now().Add(24 * time.Hour)
+	event := &models.Event{
+		OrganizerID: organizer.ID,
+		Title:       "Test event",
+		StartsAt:    startsAt,
+		EndsAt:      startsAt.Add(3 * time.Hour),
+		Capacity:    capacity,
+		Price:       10,
+		Status:      values.EventStatusPublished,
+	}
+	if err := db.Create(event).Error; err != nil {
+		t.Fatalf("creating event: %s", err)
+	}
+	return event
+}
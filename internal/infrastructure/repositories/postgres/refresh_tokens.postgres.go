@@ -0,0 +1,169 @@
+// infrastructure/repositories/postgres/refresh_tokens.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type refreshTokensRepository struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokensRepository(db *gorm.DB) *refreshTokensRepository {
+	return &refreshTokensRepository{db: db}
+}
+
+// Create operations
+
+func (r *refreshTokensRepository) CreateRefreshToken(ctx context.Context, token *entities.RefreshToken) error {
+	gormToken, err := toGormRefreshToken(token)
+	if err != nil {
+		return err
+	}
+
+	// A new family is named after its first token
+	if gormToken.FamilyID == uuid.Nil {
+		gormToken.ID = uuid.New()
+		gormToken.FamilyID = gormToken.ID
+	}
+
+	if err := r.db.WithContext(ctx).Create(gormToken).Error; err != nil {
+		return err
+	}
+
+	*token = *toDomainRefreshToken(gormToken)
+	return nil
+}
+
+// Read operations
+
+func (r *refreshTokensRepository) GetRevokedFamilies(ctx context.Context, userID string) ([]string, error) {
+	var families []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Distinct("family_id").
+		Where("user_id = ? AND revoked_at IS NOT NULL AND expires_at > ?", userID, time.Now()).
+		Pluck("family_id", &families).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(families))
+	for i, family := range families {
+		result[i] = family.String()
+	}
+	return result, nil
+}
+
+// Update operations
+
+func (r *refreshTokensRepository) RotateRefreshToken(ctx context.Context, tokenHash string, next *entities.RefreshToken) error {
+	reused := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.RefreshToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", tokenHash).
+			First(&current).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrRefreshTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if current.RevokedAt != nil || !now.Before(current.ExpiresAt) || current.UserID.String() != next.UserID {
+			return types.ErrRefreshTokenInvalid
+		}
+
+		// The token was swapped already, whoever holds the family can't be trusted anymore.
+		// The revocation is committed before the replay is reported.
+		if current.UsedAt != nil {
+			reused = true
+			return tx.Model(&models.RefreshToken{}).
+				Where("family_id = ? AND revoked_at IS NULL", current.FamilyID).
+				Update("revoked_at", now).Error
+		}
+
+		if err := tx.Model(&current).Update("used_at", now).Error; err != nil {
+			return err
+		}
+
+		gormToken, err := toGormRefreshToken(next)
+		if err != nil {
+			return err
+		}
+		gormToken.FamilyID = current.FamilyID
+		if err := tx.Create(gormToken).Error; err != nil {
+			return err
+		}
+
+		*next = *toDomainRefreshToken(gormToken)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if reused {
+		return types.ErrRefreshTokenReused
+	}
+	return nil
+}
+
+func (r *refreshTokensRepository) RevokeRefreshTokenFamily(ctx context.Context, userID, familyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND family_id = ? AND revoked_at IS NULL", userID, familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *refreshTokensRepository) RevokeOtherRefreshTokenFamilies(ctx context.Context, userID, familyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND family_id <> ? AND revoked_at IS NULL", userID, familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainRefreshToken(tokenModel *models.RefreshToken) *entities.RefreshToken {
+	return &entities.RefreshToken{
+		ID:        tokenModel.ID.String(),
+		UserID:    tokenModel.UserID.String(),
+		FamilyID:  tokenModel.FamilyID.String(),
+		TokenHash: tokenModel.TokenHash,
+		ExpiresAt: tokenModel.ExpiresAt,
+		UsedAt:    tokenModel.UsedAt,
+		RevokedAt: tokenModel.RevokedAt,
+		CreatedAt: tokenModel.CreatedAt,
+	}
+}
+
+func toGormRefreshToken(token *entities.RefreshToken) (*models.RefreshToken, error) {
+	userID, err := validateGormId(token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	familyID, err := validateGormId(token.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		RevokedAt: token.RevokedAt,
+	}, nil
+}
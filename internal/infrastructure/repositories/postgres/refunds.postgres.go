@@ -0,0 +1,240 @@
+// infrastructure/repositories/postgres/refunds.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type refundsRepository struct {
+	db *gorm.DB
+}
+
+func NewRefundsRepository(db *gorm.DB) *refundsRepository {
+	return &refundsRepository{db: db}
+}
+
+func (r *refundsRepository) CreateRefund(ctx context.Context, refund *entities.Refund) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the ticket so concurrent requests can't refund it twice
+		var ticket models.Ticket
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.TicketID).
+			First(&ticket).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrTicketNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.Refund{}).
+			Where("ticket_id = ? AND status IN ?", refund.TicketID, []string{values.RefundStatusPending, values.RefundStatusCompleted}).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrRefundAlreadyRequested
+		}
+
+		gormRefund, err := toGormRefund(refund)
+		if err != nil {
+			return err
+		}
+		gormRefund.Status = values.RefundStatusPending
+
+		if err := tx.Create(gormRefund).Error; err != nil {
+			return err
+		}
+
+		*refund = *toDomainRefund(gormRefund)
+		return nil
+	})
+}
+
+func (r *refundsRepository) GetRefundsByEvent(ctx context.Context, eventID, status string) ([]*entities.Refund, error) {
+	var refunds []models.Refund
+	query := r.db.WithContext(ctx).Where("event_id = ?", eventID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Order("created_at DESC").Find(&refunds).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainRefunds(refunds), nil
+}
+
+func (r *refundsRepository) GetRefundsByUser(ctx context.Context, userID, status string) ([]*entities.Refund, error) {
+	var refunds []models.Refund
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Order("created_at DESC").Find(&refunds).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainRefunds(refunds), nil
+}
+
+func (r *refundsRepository) CompleteRefund(ctx context.Context, refundID, providerRefundID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var refund models.Refund
+		err := tx.Where("id = ?", refundID).First(&refund).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrRefundNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		// The payment callbacks lock the payment first, the refund is locked after it
+		payment, _, err := lockPayment(tx, refund.PaymentID.String())
+		if err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refundID).
+			First(&refund).Error; err != nil {
+			return err
+		}
+
+		// The provider's callback completed it first
+		if refund.Status == values.RefundStatusCompleted {
+			return nil
+		}
+
+		if err := completeRefund(tx, &refund, providerRefundID); err != nil {
+			return err
+		}
+
+		// The payment is refunded once its refunds add up to its amount
+		var refunded float64
+		if err := tx.Model(&models.Refund{}).
+			Select("COALESCE(SUM(amount), 0)").
+			Where("payment_id = ? AND status = ?", payment.ID, values.RefundStatusCompleted).
+			Scan(&refunded).Error; err != nil {
+			return err
+		}
+		if payment.Status != values.PaymentStatusCompleted || !coversAmount(refunded, payment.Amount) {
+			return nil
+		}
+
+		return tx.Model(payment).
+			Update("status", values.PaymentStatusRefunded).Error
+	})
+}
+
+func (r *refundsRepository) FailRefund(ctx context.Context, refundID, failureReason string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.Refund{}).
+		Where("id = ?", refundID).
+		Updates(map[string]interface{}{
+			"status":         values.RefundStatusFailed,
+			"failure_reason": failureReason,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrRefundNotFound
+	}
+	return nil
+}
+
+// completeRefund marks a refund completed and its ticket refunded, which gives the ticket's
+// place back. Callers hold the locks of the refund's payment.
+func completeRefund(tx *gorm.DB, refund *models.Refund, providerRefundID string) error {
+	if err := tx.Model(refund).Updates(map[string]interface{}{
+		"status":             values.RefundStatusCompleted,
+		"provider_refund_id": providerRefundID,
+	}).Error; err != nil {
+		return err
+	}
+
+	var tickets []models.Ticket
+	if err := tx.Where("id = ? AND status = ?", refund.TicketID, values.TicketStatusPaid).
+		Find(&tickets).Error; err != nil {
+		return err
+	}
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	if err := tx.Model(&tickets).
+		Updates(map[string]interface{}{"status": values.TicketStatusRefunded, "refund_pending": false}).Error; err != nil {
+		return err
+	}
+	return releaseEventCapacity(tx, tickets)
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainRefunds(refunds []models.Refund) []*entities.Refund {
+	result := make([]*entities.Refund, len(refunds))
+	for i, refund := range refunds {
+		result[i] = toDomainRefund(&refund)
+	}
+	return result
+}
+
+func toDomainRefund(refundModel *models.Refund) *entities.Refund {
+	return &entities.Refund{
+		ID:               refundModel.ID.String(),
+		TicketID:         refundModel.TicketID.String(),
+		PaymentID:        refundModel.PaymentID.String(),
+		EventID:          refundModel.EventID.String(),
+		UserID:           refundModel.UserID.String(),
+		ProviderRefundID: refundModel.ProviderRefundID,
+		Amount:           refundModel.Amount,
+		Reason:           refundModel.Reason,
+		Status:           refundModel.Status,
+		FailureReason:    refundModel.FailureReason,
+		CreatedAt:        refundModel.CreatedAt,
+	}
+}
+
+func toGormRefund(refund *entities.Refund) (*models.Refund, error) {
+	ticketID, err := validateGormId(refund.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	paymentID, err := validateGormId(refund.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := validateGormId(refund.EventID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := validateGormId(refund.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Refund{
+		TicketID:  ticketID,
+		PaymentID: paymentID,
+		EventID:   eventID,
+		UserID:    userID,
+		Amount:    refund.Amount,
+		Reason:    refund.Reason,
+		Status:    refund.Status,
+	}, nil
+}
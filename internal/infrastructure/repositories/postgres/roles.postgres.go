@@ -0,0 +1,176 @@
+// infrastructure/repositories/postgres/roles.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type rolesRepository struct {
+	db *gorm.DB
+}
+
+func NewRolesRepository(db *gorm.DB) *rolesRepository {
+	return &rolesRepository{db: db}
+}
+
+// Create operations
+
+func (r *rolesRepository) CreateRole(ctx context.Context, role *entities.Role) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Role{}).Where("name = ?", role.Name).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrRoleExists
+		}
+
+		gormRole := toGormRole(role)
+		if err := tx.Create(gormRole).Error; err != nil {
+			return err
+		}
+
+		*role = *toDomainRole(gormRole)
+		return nil
+	})
+}
+
+// Read operations
+
+func (r *rolesRepository) GetRoles(ctx context.Context) ([]*entities.Role, error) {
+	var roles []models.Role
+	if err := r.db.WithContext(ctx).
+		Preload("Permissions").
+		Order("name ASC").
+		Find(&roles).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.Role, len(roles))
+	for i := range roles {
+		result[i] = toDomainRole(&roles[i])
+	}
+	return result, nil
+}
+
+func (r *rolesRepository) GetRoleByName(ctx context.Context, name string) (*entities.Role, error) {
+	var role models.Role
+	err := r.db.WithContext(ctx).
+		Preload("Permissions").
+		Where("name = ?", name).
+		First(&role).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainRole(&role), nil
+}
+
+// Update operations
+
+func (r *rolesRepository) UpdateRole(ctx context.Context, role *entities.Role) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.Role
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("name = ?", role.Name).
+			First(&existing).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrRoleNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&existing).Update("description", role.Description).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("role_name = ?", existing.Name).Delete(&models.RolePermission{}).Error; err != nil {
+			return err
+		}
+		existing.Permissions = toGormRole(role).Permissions
+		if len(existing.Permissions) > 0 {
+			if err := tx.Create(&existing.Permissions).Error; err != nil {
+				return err
+			}
+		}
+
+		*role = *toDomainRole(&existing)
+		return nil
+	})
+}
+
+// Delete operations
+
+func (r *rolesRepository) DeleteRole(ctx context.Context, name string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.User{}).
+			Where("role = ?", name).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrRoleInUse
+		}
+
+		result := tx.Where("name = ?", name).Delete(&models.Role{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrRoleNotFound
+		}
+		return nil
+	})
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainRole(roleModel *models.Role) *entities.Role {
+	permissions := make([]entities.Permission, len(roleModel.Permissions))
+	for i, permission := range roleModel.Permissions {
+		permissions[i] = entities.Permission{
+			Resource: permission.Resource,
+			Action:   permission.Action,
+			Scope:    permission.Scope,
+		}
+	}
+
+	return &entities.Role{
+		Name:        roleModel.Name,
+		Description: roleModel.Description,
+		Permissions: permissions,
+		CreatedAt:   roleModel.CreatedAt,
+	}
+}
+
+func toGormRole(role *entities.Role) *models.Role {
+	permissions := make([]models.RolePermission, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = models.RolePermission{
+			RoleName: role.Name,
+			Resource: permission.Resource,
+			Action:   permission.Action,
+			Scope:    permission.Scope,
+		}
+	}
+
+	return &models.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+	}
+}
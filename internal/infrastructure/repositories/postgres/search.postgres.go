@@ -0,0 +1,141 @@
+// infrastructure/repositories/postgres/search.postgres.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Matches an event when the full-text query hits its search_vector, or when the
+// search term is close enough to a part of the title or description to be a typo.
+// `<%` uses pg_trgm.word_similarity_threshold and the trigram indexes.
+const eventSearchCondition = `(events.search_vector @@ websearch_to_tsquery(?::regconfig, ?)
+	OR ? <% events.title
+	OR ? <% events.description)`
+
+// escapeHTML escapes a column like html.EscapeString does. The highlights are HTML, so the
+// text is escaped before ts_headline adds the markers, which passes markup through untouched.
+const escapeHTML = `replace(replace(replace(replace(replace(%s,
+	'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;')`
+
+var eventSearchColumns = fmt.Sprintf(`events.*,
+	ts_rank(events.search_vector, websearch_to_tsquery(?::regconfig, ?)) + word_similarity(?, events.title) AS rank,
+	ts_headline(?::regconfig, %s, websearch_to_tsquery(?::regconfig, ?), ?) AS title_highlight,
+	ts_headline(?::regconfig, %s, websearch_to_tsquery(?::regconfig, ?), ?) AS snippet`,
+	fmt.Sprintf(escapeHTML, "events.title"), fmt.Sprintf(escapeHTML, "events.description"))
+
+var (
+	titleHighlightOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true",
+		values.SearchHighlightStart, values.SearchHighlightStop)
+	snippetHighlightOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MinWords=5, MaxWords=25",
+		values.SearchHighlightStart, values.SearchHighlightStop)
+)
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) *searchRepository {
+	return &searchRepository{db: db}
+}
+
+type eventSearchRow struct {
+	models.Event   `gorm:"embedded"`
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+func (r *searchRepository) SearchEvents(ctx context.Context, filter *entities.EventFilter) ([]*entities.EventSearchHit, int64, error) {
+	term := filter.Search
+	lang := values.SearchLanguage
+
+	var total int64
+	err := r.searchQuery(ctx, filter).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := "rank DESC, events.id ASC"
+	if filter.SortBy != "" && filter.SortBy != values.EventSortRelevance {
+		order = eventOrder(filter)
+	}
+
+	var rows []eventSearchRow
+	err = r.searchQuery(ctx, filter).
+		Select(eventSearchColumns,
+			lang, term, term,
+			lang, lang, term, titleHighlightOptions,
+			lang, lang, term, snippetHighlightOptions).
+		Order(order).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]*models.Event, len(rows))
+	for i := range rows {
+		events[i] = &rows[i].Event
+	}
+	if err := loadEventTags(r.db.WithContext(ctx), events); err != nil {
+		return nil, 0, err
+	}
+
+	hits := make([]*entities.EventSearchHit, len(rows))
+	for i := range rows {
+		hits[i] = &entities.EventSearchHit{
+			Event: toDomainEvent(&rows[i].Event),
+			Highlight: entities.EventHighlight{
+				Rank:    rows[i].Rank,
+				Title:   rows[i].TitleHighlight,
+				Snippet: rows[i].Snippet,
+			},
+		}
+	}
+
+	return hits, total, nil
+}
+
+func (r *searchRepository) searchQuery(ctx context.Context, filter *entities.EventFilter) *gorm.DB {
+	query := applyEventFilter(r.db.WithContext(ctx).Model(&models.Event{}), filter)
+	return applyEventSearch(query, filter.Search)
+}
+
+// applyEventSearch keeps the events matching the search term
+func applyEventSearch(query *gorm.DB, term string) *gorm.DB {
+	return query.Where(eventSearchCondition, values.SearchLanguage, term, term, term)
+}
+
+// loadEventTags fills the tags of events that were scanned rather than preloaded
+func loadEventTags(db *gorm.DB, events []*models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*models.Event, len(events))
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		byID[event.ID] = event
+		ids[i] = event.ID
+	}
+
+	var tags []models.EventTag
+	if err := db.Where("event_id IN ?", ids).Order("tag ASC").Find(&tags).Error; err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		event := byID[tag.EventID]
+		event.Tags = append(event.Tags, tag)
+	}
+	return nil
+}
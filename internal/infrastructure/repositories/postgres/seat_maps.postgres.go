@@ -0,0 +1,339 @@
+// infrastructure/repositories/postgres/seat_maps.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const seatsBatchSize = 1000
+
+type seatMapsRepository struct {
+	db *gorm.DB
+}
+
+func NewSeatMapsRepository(db *gorm.DB) *seatMapsRepository {
+	return &seatMapsRepository{db: db}
+}
+
+func (r *seatMapsRepository) CreateSeatMap(ctx context.Context, seatMap *entities.SeatMap) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		organizerID, err := validateGormId(seatMap.OrganizerID)
+		if err != nil {
+			return err
+		}
+
+		// IDs are generated here so every level can reference its parent
+		gormSeatMap := &models.SeatMap{
+			ID:          uuid.New(),
+			OrganizerID: organizerID,
+			VenueID:     optionalGormId(seatMap.VenueID),
+			Name:        seatMap.Name,
+		}
+
+		var sections []models.SeatSection
+		var rows []models.SeatRow
+		var seats []models.Seat
+		for i, section := range seatMap.Sections {
+			gormSection := models.SeatSection{
+				ID:        uuid.New(),
+				SeatMapID: gormSeatMap.ID,
+				Name:      section.Name,
+				Position:  i,
+			}
+			for j, row := range section.Rows {
+				gormRow := models.SeatRow{
+					ID:        uuid.New(),
+					SectionID: gormSection.ID,
+					Label:     row.Label,
+					Position:  j,
+				}
+				for k, seat := range row.Seats {
+					gormRow.Seats = append(gormRow.Seats, models.Seat{
+						ID:        uuid.New(),
+						RowID:     gormRow.ID,
+						SeatMapID: gormSeatMap.ID,
+						Number:    seat.Number,
+						Position:  k,
+					})
+				}
+				seats = append(seats, gormRow.Seats...)
+				rows = append(rows, gormRow)
+				gormSection.Rows = append(gormSection.Rows, gormRow)
+			}
+			sections = append(sections, gormSection)
+			gormSeatMap.Sections = append(gormSeatMap.Sections, gormSection)
+		}
+		gormSeatMap.SeatsCount = len(seats)
+
+		if err := tx.Omit(clause.Associations).Create(gormSeatMap).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit(clause.Associations).Create(&sections).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit(clause.Associations).CreateInBatches(&rows, seatsBatchSize).Error; err != nil {
+			return err
+		}
+		if err := tx.CreateInBatches(&seats, seatsBatchSize).Error; err != nil {
+			return err
+		}
+
+		*seatMap = *toDomainSeatMap(gormSeatMap, nil)
+		return nil
+	})
+}
+
+func (r *seatMapsRepository) GetSeatMapByID(ctx context.Context, seatMapID string) (*entities.SeatMap, error) {
+	seatMap, err := r.getSeatMapWithSeats(ctx, seatMapID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainSeatMap(seatMap, nil), nil
+}
+
+func (r *seatMapsRepository) GetSeatMapsByOrganizer(ctx context.Context, organizerID string) ([]*entities.SeatMap, error) {
+	var seatMaps []models.SeatMap
+	err := r.db.WithContext(ctx).
+		Where("organizer_id = ?", organizerID).
+		Order("created_at DESC").
+		Find(&seatMaps).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.SeatMap, len(seatMaps))
+	for i, seatMap := range seatMaps {
+		result[i] = toDomainSeatMap(&seatMap, nil)
+	}
+	return result, nil
+}
+
+func (r *seatMapsRepository) GetEventSeats(ctx context.Context, eventID string) (*entities.SeatMap, error) {
+	var event models.Event
+	err := r.db.WithContext(ctx).
+		Select("id, seat_map_id").
+		Where("id = ?", eventID).
+		First(&event).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if event.SeatMapID == nil {
+		return nil, types.ErrSeatMapNotFound
+	}
+
+	seatMap, err := r.getSeatMapWithSeats(ctx, event.SeatMapID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var heldSeatIDs []uuid.UUID
+	if err := r.db.WithContext(ctx).Model(&models.Ticket{}).
+		Where("event_id = ? AND seat_id IS NOT NULL AND status IN ?", eventID,
+			[]string{values.TicketStatusReserved, values.TicketStatusPaid}).
+		Pluck("seat_id", &heldSeatIDs).Error; err != nil {
+		return nil, err
+	}
+
+	held := make(map[uuid.UUID]bool, len(heldSeatIDs))
+	for _, seatID := range heldSeatIDs {
+		held[seatID] = true
+	}
+
+	return toDomainSeatMap(seatMap, held), nil
+}
+
+func (r *seatMapsRepository) AttachSeatMapToEvent(ctx context.Context, eventID, seatMapID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var event models.Event
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", eventID).
+			First(&event).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrEventNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		// Anonymous tickets can't be moved onto seats
+		if event.TicketsSold > 0 {
+			return types.ErrSeatMapLocked
+		}
+
+		var seatMap models.SeatMap
+		err = tx.Where("id = ?", seatMapID).First(&seatMap).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrSeatMapNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		// Seat maps drawn for a venue only fit events held there
+		if seatMap.VenueID != nil && (event.VenueID == nil || *event.VenueID != *seatMap.VenueID) {
+			return types.ErrSeatMapVenueMismatch
+		}
+
+		var allocated int64
+		if err := tx.Model(&models.TicketType{}).
+			Select("COALESCE(SUM(capacity), 0)").
+			Where("event_id = ?", eventID).
+			Scan(&allocated).Error; err != nil {
+			return err
+		}
+		if int(allocated) > seatMap.SeatsCount {
+			return types.ErrTicketTypeCapacityExceeded
+		}
+
+		// The seats become the capacity, which must fit into the venue. The venue stays locked
+		// so its capacity can't shrink under the event meanwhile.
+		if event.VenueID != nil {
+			var venue models.Venue
+			if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+				Where("id = ?", *event.VenueID).
+				First(&venue).Error; err != nil {
+				return err
+			}
+			if seatMap.SeatsCount > venue.DefaultCapacity {
+				return types.ErrVenueCapacityExceeded
+			}
+		}
+
+		// A seated event sells exactly its seats
+		return tx.Model(&event).Updates(map[string]interface{}{
+			"seat_map_id": seatMap.ID,
+			"capacity":    seatMap.SeatsCount,
+		}).Error
+	})
+}
+
+func (r *seatMapsRepository) ValidateSeatMapOwnership(ctx context.Context, seatMapID, organizerID string) error {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.SeatMap{}).
+		Where("id = ? AND organizer_id = ?", seatMapID, organizerID).
+		Count(&count).Error
+
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrSeatMapNotFound
+	}
+	return nil
+}
+
+func (r *seatMapsRepository) getSeatMapWithSeats(ctx context.Context, seatMapID string) (*models.SeatMap, error) {
+	var seatMap models.SeatMap
+	err := r.db.WithContext(ctx).
+		Preload("Sections", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Sections.Rows", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Sections.Rows.Seats", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Where("id = ?", seatMapID).
+		First(&seatMap).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrSeatMapNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &seatMap, nil
+}
+
+// checkSeatSelection makes sure every selected seat belongs to the event's map,
+// is picked once and isn't held by another live ticket. Callers hold the event
+// lock, the unique index on tickets backs this up.
+func checkSeatSelection(tx *gorm.DB, event *models.Event, seatIDs []string) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+
+	unique := make(map[string]bool, len(seatIDs))
+	for _, seatID := range seatIDs {
+		if unique[seatID] {
+			return types.ErrInvalidSeatSelection
+		}
+		unique[seatID] = true
+	}
+
+	var found int64
+	if err := tx.Model(&models.Seat{}).
+		Where("id IN ? AND seat_map_id = ?", seatIDs, event.SeatMapID).
+		Count(&found).Error; err != nil {
+		return err
+	}
+	if int(found) != len(seatIDs) {
+		return types.ErrInvalidSeatSelection
+	}
+
+	var held int64
+	if err := tx.Model(&models.Ticket{}).
+		Where("event_id = ? AND seat_id IN ? AND status IN ?", event.ID, seatIDs,
+			[]string{values.TicketStatusReserved, values.TicketStatusPaid}).
+		Count(&held).Error; err != nil {
+		return err
+	}
+	if held > 0 {
+		return types.ErrSeatNotAvailable
+	}
+
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainSeatMap(seatMapModel *models.SeatMap, held map[uuid.UUID]bool) *entities.SeatMap {
+	seatMap := &entities.SeatMap{
+		ID:          seatMapModel.ID.String(),
+		OrganizerID: seatMapModel.OrganizerID.String(),
+		VenueID:     optionalId(seatMapModel.VenueID),
+		Name:        seatMapModel.Name,
+		SeatsCount:  seatMapModel.SeatsCount,
+		CreatedAt:   seatMapModel.CreatedAt,
+	}
+
+	for _, section := range seatMapModel.Sections {
+		domainSection := &entities.SeatSection{
+			ID:   section.ID.String(),
+			Name: section.Name,
+		}
+		for _, row := range section.Rows {
+			domainRow := &entities.SeatRow{
+				ID:    row.ID.String(),
+				Label: row.Label,
+			}
+			for _, seat := range row.Seats {
+				domainSeat := &entities.Seat{
+					ID:     seat.ID.String(),
+					Number: seat.Number,
+				}
+				if held != nil {
+					available := !held[seat.ID]
+					domainSeat.Available = &available
+				}
+				domainRow.Seats = append(domainRow.Seats, domainSeat)
+			}
+			domainSection.Rows = append(domainSection.Rows, domainRow)
+		}
+		seatMap.Sections = append(seatMap.Sections, domainSection)
+	}
+
+	return seatMap
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+)
+
+func TestAttachSeatMapKeepsEventWithinVenue(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	event := createTestEvent(t, db, 2)
+
+	venue := &models.Venue{
+		OrganizerID:     event.OrganizerID,
+		Name:            "Small hall",
+		Address:         "1 Test street",
+		City:            "Test city",
+		Country:         "Test country",
+		DefaultCapacity: 2,
+	}
+	if err := db.Create(venue).Error; err != nil {
+		t.Fatalf("creating venue: %s", err)
+	}
+	if err := db.Model(event).Update("venue_id", venue.ID).Error; err != nil {
+		t.Fatalf("moving event to the venue: %s", err)
+	}
+
+	repo := NewSeatMapsRepository(db)
+	seatMap := &entities.SeatMap{
+		OrganizerID: event.OrganizerID.String(),
+		VenueID:     venue.ID.String(),
+		Name:        "Too many seats",
+		Sections: []*entities.SeatSection{{Name: "Floor", Rows: []*entities.SeatRow{{Label: "A",
+			Seats: []*entities.Seat{{Number: "1"}, {Number: "2"}, {Number: "3"}}}}}},
+	}
+	if err := repo.CreateSeatMap(ctx, seatMap); err != nil {
+		t.Fatalf("CreateSeatMap: %s", err)
+	}
+
+	err := repo.AttachSeatMapToEvent(ctx, event.ID.String(), seatMap.ID)
+	if !errors.Is(err, types.ErrVenueCapacityExceeded) {
+		t.Fatalf("AttachSeatMapToEvent error = %v, want %v", err, types.ErrVenueCapacityExceeded)
+	}
+
+	var stored models.Event
+	if err := db.First(&stored, "id = ?", event.ID).Error; err != nil {
+		t.Fatalf("reading event: %s", err)
+	}
+	if stored.Capacity != 2 || stored.SeatMapID != nil {
+		t.Errorf("event capacity = %d with seat map %v, want 2 without one", stored.Capacity, stored.SeatMapID)
+	}
+}
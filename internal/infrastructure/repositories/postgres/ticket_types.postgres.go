@@ -0,0 +1,275 @@
+// infrastructure/repositories/postgres/ticket_types.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ticketTypeEditableColumns are written on update, so zero values like a free tier are kept.
+var ticketTypeEditableColumns = []string{
+	"name", "price", "capacity", "sales_start_at", "sales_end_at", "max_per_user",
+}
+
+type ticketTypesRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketTypesRepository(db *gorm.DB) *ticketTypesRepository {
+	return &ticketTypesRepository{db: db}
+}
+
+func (r *ticketTypesRepository) CreateTicketType(ctx context.Context, ticketType *entities.TicketType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gormTicketType, err := toGormTicketType(ticketType)
+		if err != nil {
+			return err
+		}
+
+		event, err := lockTicketTypeEvent(tx, gormTicketType.EventID)
+		if err != nil {
+			return err
+		}
+		if err := checkTicketTypeAllocation(tx, event, gormTicketType); err != nil {
+			return err
+		}
+
+		if err := tx.Create(gormTicketType).Error; err != nil {
+			return err
+		}
+
+		*ticketType = *toDomainTicketType(gormTicketType)
+		return nil
+	})
+}
+
+func (r *ticketTypesRepository) GetTicketTypeByID(ctx context.Context, ticketTypeID string) (*entities.TicketType, error) {
+	var ticketType models.TicketType
+	err := r.db.WithContext(ctx).
+		Where("id = ?", ticketTypeID).
+		First(&ticketType).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrTicketTypeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTicketType(&ticketType), nil
+}
+
+func (r *ticketTypesRepository) GetTicketTypesByEvent(ctx context.Context, eventID string) ([]*entities.TicketType, error) {
+	var ticketTypes []models.TicketType
+	err := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("price ASC, created_at ASC").
+		Find(&ticketTypes).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTicketTypes(ticketTypes), nil
+}
+
+func (r *ticketTypesRepository) UpdateTicketType(ctx context.Context, ticketType *entities.TicketType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gormTicketType, err := toGormTicketType(ticketType)
+		if err != nil {
+			return err
+		}
+
+		event, err := lockTicketTypeEvent(tx, gormTicketType.EventID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := lockTicketType(tx, gormTicketType.ID, event.ID)
+		if err != nil {
+			return err
+		}
+
+		// Tickets already sold can't be taken away
+		if gormTicketType.Capacity < existing.TicketsSold {
+			return types.ErrTicketTypeCapacityExceeded
+		}
+		if err := checkTicketTypeAllocation(tx, event, gormTicketType); err != nil {
+			return err
+		}
+
+		if err := tx.Model(existing).
+			Select(ticketTypeEditableColumns).
+			Updates(gormTicketType).Error; err != nil {
+			return err
+		}
+
+		gormTicketType.TicketsSold = existing.TicketsSold
+		gormTicketType.CreatedAt = existing.CreatedAt
+		*ticketType = *toDomainTicketType(gormTicketType)
+		return nil
+	})
+}
+
+func (r *ticketTypesRepository) DeleteTicketType(ctx context.Context, ticketTypeID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The tier is read first only to find its event, the event never changes
+		var unlocked models.TicketType
+		err := tx.Where("id = ?", ticketTypeID).First(&unlocked).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrTicketTypeNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		event, err := lockTicketTypeEvent(tx, unlocked.EventID)
+		if err != nil {
+			return err
+		}
+
+		ticketType, err := lockTicketType(tx, unlocked.ID, event.ID)
+		if err != nil {
+			return err
+		}
+
+		if ticketType.TicketsSold > 0 {
+			return types.ErrTicketTypeInUse
+		}
+
+		return tx.Delete(ticketType).Error
+	})
+}
+
+// lockTicketTypeEvent locks the event of the tiers being changed so concurrent edits of
+// its tiers are checked one after another. Reservations lock the event before its tiers
+// too, taking the locks in the same order keeps the two from deadlocking.
+func lockTicketTypeEvent(tx *gorm.DB, eventID uuid.UUID) (*models.Event, error) {
+	var event models.Event
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", eventID).
+		First(&event).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// lockTicketType locks a tier of an event, the event must be locked already
+func lockTicketType(tx *gorm.DB, ticketTypeID, eventID uuid.UUID) (*models.TicketType, error) {
+	var ticketType models.TicketType
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", ticketTypeID, eventID).
+		First(&ticketType).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrTicketTypeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketType, nil
+}
+
+// checkTicketTypeAllocation makes sure the tiers of an event never promise more
+// places than the event has. The event must be locked by lockTicketTypeEvent.
+func checkTicketTypeAllocation(tx *gorm.DB, event *models.Event, ticketType *models.TicketType) error {
+	var allocated int64
+	if err := tx.Model(&models.TicketType{}).
+		Select("COALESCE(SUM(capacity), 0)").
+		Where("event_id = ? AND id <> ?", event.ID, ticketType.ID).
+		Scan(&allocated).Error; err != nil {
+		return err
+	}
+
+	if int(allocated)+ticketType.Capacity > event.Capacity {
+		return types.ErrTicketTypeCapacityExceeded
+	}
+	return nil
+}
+
+// checkEventCapacity makes sure a new capacity of the event still holds the tickets sold
+// and the places its tiers promise. The event must be locked.
+func checkEventCapacity(tx *gorm.DB, event *models.Event, capacity int) error {
+	if capacity < event.TicketsSold {
+		return types.ErrEventCapacityBelowSold
+	}
+
+	var allocated int64
+	if err := tx.Model(&models.TicketType{}).
+		Select("COALESCE(SUM(capacity), 0)").
+		Where("event_id = ?", event.ID).
+		Scan(&allocated).Error; err != nil {
+		return err
+	}
+	if int(allocated) > capacity {
+		return types.ErrTicketTypeCapacityExceeded
+	}
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainTicketTypes(ticketTypes []models.TicketType) []*entities.TicketType {
+	result := make([]*entities.TicketType, len(ticketTypes))
+	for i, ticketType := range ticketTypes {
+		result[i] = toDomainTicketType(&ticketType)
+	}
+	return result
+}
+
+func toDomainTicketType(ticketTypeModel *models.TicketType) *entities.TicketType {
+	return &entities.TicketType{
+		ID:           ticketTypeModel.ID.String(),
+		EventID:      ticketTypeModel.EventID.String(),
+		Name:         ticketTypeModel.Name,
+		Price:        ticketTypeModel.Price,
+		Capacity:     ticketTypeModel.Capacity,
+		TicketsSold:  ticketTypeModel.TicketsSold,
+		SalesStartAt: ticketTypeModel.SalesStartAt,
+		SalesEndAt:   ticketTypeModel.SalesEndAt,
+		MaxPerUser:   ticketTypeModel.MaxPerUser,
+		CreatedAt:    ticketTypeModel.CreatedAt,
+	}
+}
+
+func toGormTicketType(ticketType *entities.TicketType) (*models.TicketType, error) {
+	var ticketTypeID uuid.UUID
+	var err error
+	if ticketType.ID != "" {
+		ticketTypeID, err = validateGormId(ticketType.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	eventID, err := validateGormId(ticketType.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TicketType{
+		ID:           ticketTypeID,
+		EventID:      eventID,
+		Name:         ticketType.Name,
+		Price:        ticketType.Price,
+		Capacity:     ticketType.Capacity,
+		SalesStartAt: ticketType.SalesStartAt,
+		SalesEndAt:   ticketType.SalesEndAt,
+		MaxPerUser:   ticketType.MaxPerUser,
+	}, nil
+}
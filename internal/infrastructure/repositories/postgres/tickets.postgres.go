@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ticketsRepository struct {
@@ -25,87 +26,32 @@ func NewTicketsRepository(db *gorm.DB) *ticketsRepository {
 
 func (r *ticketsRepository) CreateTicket(ctx context.Context, eventID, userID string, ticket *entities.Ticket) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// First get event to get the price
-		var event models.Event
-		if err := tx.Where("id = ?", eventID).First(&event).Error; err != nil {
-			return err
+		selection := entities.TicketSelection{TicketTypeID: ticket.TicketTypeID, Quantity: 1}
+		if ticket.SeatID != "" {
+			selection.SeatIDs = []string{ticket.SeatID}
 		}
+		selections := []entities.TicketSelection{selection}
 
-		gormTicket := toGormTicket(ticket)
-
-		eventUUID, err := validateGormId(eventID)
+		gormTickets, err := reserveTickets(tx, eventID, userID, selections)
 		if err != nil {
 			return err
 		}
-		userUUID, err := validateGormId(userID)
-		if err != nil {
-			return err
-		}
-
-		gormTicket.EventID = eventUUID
-		gormTicket.UserID = userUUID
-		gormTicket.Status = values.TicketStatusReserved
-		gormTicket.ReservedAt = time.Now()
-		gormTicket.Price = event.Price
-
-		if err := tx.Create(gormTicket).Error; err != nil {
-			return err
-		}
-
-		// Update event's tickets_sold count
-		if err := tx.Model(&event).
-			Update("tickets_sold", gorm.Expr("tickets_sold + ?", 1)).
-			Error; err != nil {
-			return err
-		}
 
+		*ticket = *toDomainTicket(&gormTickets[0])
 		return nil
 	})
 }
 
-func (r *ticketsRepository) CreateTickets(ctx context.Context, eventID, userID string, count int) ([]*entities.Ticket, error) {
+func (r *ticketsRepository) CreateTickets(ctx context.Context, eventID, userID string, selections []entities.TicketSelection) ([]*entities.Ticket, error) {
 	var tickets []*entities.Ticket
 
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// First get event to get the price
-		var event models.Event
-		if err := tx.Where("id = ?", eventID).First(&event).Error; err != nil {
-			return err
-		}
-
-		eventUUID, err := validateGormId(eventID)
-		if err != nil {
-			return err
-		}
-		userUUID, err := validateGormId(userID)
+		gormTickets, err := reserveTickets(tx, eventID, userID, selections)
 		if err != nil {
 			return err
 		}
 
-		// Create tickets
-		for i := 0; i < count; i++ {
-			gormTicket := &models.Ticket{
-				EventID:    eventUUID,
-				UserID:     userUUID,
-				Status:     values.TicketStatusReserved,
-				ReservedAt: time.Now(),
-				Price:      event.Price,
-			}
-
-			if err := tx.Create(gormTicket).Error; err != nil {
-				return err
-			}
-
-			tickets = append(tickets, toDomainTicket(gormTicket))
-		}
-
-		// Update event's tickets_sold count
-		if err := tx.Model(&event).
-			Update("tickets_sold", gorm.Expr("tickets_sold + ?", count)).
-			Error; err != nil {
-			return err
-		}
-
+		tickets = toDomainTickets(gormTickets)
 		return nil
 	})
 
@@ -190,55 +136,108 @@ func (r *ticketsRepository) UpdateTicketStatus(ctx context.Context, ticketID str
 	return nil
 }
 
+// UpdateTicketPayment marks a reserved ticket paid.
 func (r *ticketsRepository) UpdateTicketPayment(ctx context.Context, ticketID string, paidAt time.Time) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		result := tx.Model(&models.Ticket{}).
+		return updateTicketPayment(tx, ticketID, paidAt)
+	})
+}
+
+// CancelTicket cancels a reserved ticket that isn't part of a checkout and gives its place back to the event.
+func (r *ticketsRepository) CancelTicket(ctx context.Context, ticketID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ticket models.Ticket
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
 			Where("id = ?", ticketID).
-			Updates(map[string]interface{}{
-				"status":  values.TicketStatusPaid,
-				"paid_at": paidAt,
-			})
+			First(&ticket).Error
 
-		if result.Error != nil {
-			return result.Error
-		}
-		if result.RowsAffected == 0 {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return types.ErrTicketNotFound
 		}
-		return nil
+		if err != nil {
+			return err
+		}
+
+		if ticket.Status != values.TicketStatusReserved || ticket.PaymentID != nil {
+			return types.ErrInvalidTicketStatus
+		}
+
+		if err := tx.Model(&ticket).
+			Update("status", values.TicketStatusCancelled).Error; err != nil {
+			return err
+		}
+
+		return releaseEventCapacity(tx, []models.Ticket{ticket})
 	})
 }
 
-func (r *ticketsRepository) UpdateExpiredTickets(ctx context.Context) error {
-	// Get reservation expiration time (e.g., 15 minutes)
-	expirationTime := time.Now().Add(-15 * time.Minute)
+func (r *ticketsRepository) UpdateExpiredTickets(ctx context.Context) (int64, error) {
+	var expired int64
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Update expired reserved tickets
-		result := tx.Model(&models.Ticket{}).
-			Where("status = ? AND reserved_at <= ?", values.TicketStatusReserved, expirationTime).
-			Update("status", values.TicketStatusExpired)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Each event decides how long its reservations are held, tickets in a
+		// checkout included. A checkout that isn't paid by then is abandoned.
+		var tickets []models.Ticket
+		err := tx.Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Table:    clause.Table{Name: clause.CurrentTable},
+			Options:  "SKIP LOCKED",
+		}).
+			Joins("JOIN events ON events.id = tickets.event_id").
+			Where("tickets.status = ?", values.TicketStatusReserved).
+			Where("tickets.reserved_at <= NOW() - make_interval(mins => events.reservation_ttl_minutes)").
+			Find(&tickets).Error
+		if err != nil {
+			return err
+		}
+		if len(tickets) == 0 {
+			return nil
+		}
 
-		if result.Error != nil {
-			return result.Error
+		if err := tx.Model(&tickets).
+			Update("status", values.TicketStatusExpired).Error; err != nil {
+			return err
 		}
 
-		return nil
+		// The abandoned checkouts fail, a charge that still comes in for them is refunded
+		if paymentIDs := ticketPaymentIDs(tickets); len(paymentIDs) > 0 {
+			if err := failPayments(tx, paymentIDs); err != nil {
+				return err
+			}
+		}
+
+		expired = int64(len(tickets))
+		return releaseEventCapacity(tx, tickets)
 	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
 }
 
 func (r *ticketsRepository) CancelEventTickets(ctx context.Context, eventID string) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		result := tx.Model(&models.Ticket{}).
-			Where("event_id = ? AND status = ?", eventID, values.TicketStatusReserved).
-			Update("status", values.TicketStatusCancelled)
+		return cancelEventTickets(tx, eventID)
+	})
+}
 
-		if result.Error != nil {
-			return result.Error
-		}
+// GetRefundPendingTickets returns the paid tickets still waiting for the refund of their
+// cancelled event, an empty eventID returns them for every event
+func (r *ticketsRepository) GetRefundPendingTickets(ctx context.Context, eventID string) ([]*entities.Ticket, error) {
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND refund_pending", values.TicketStatusPaid)
+	if eventID != "" {
+		query = query.Where("event_id = ?", eventID)
+	}
 
-		return nil
-	})
+	var tickets []models.Ticket
+	if err := query.Order("created_at").Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainTickets(tickets), nil
 }
 
 func (r *ticketsRepository) CheckTicketAvailability(ctx context.Context, eventID string, count int) (bool, error) {
@@ -273,6 +272,290 @@ func (r *ticketsRepository) ValidateTicketOwnership(ctx context.Context, ticketI
 	return nil
 }
 
+// reserveTickets creates reserved tickets for the selections, taking their places
+// from the event and from the chosen ticket types. Each ticket keeps the price
+// of its tier at the time of the reservation, seated tickets hold their seat.
+func reserveTickets(tx *gorm.DB, eventID, userID string, selections []entities.TicketSelection) ([]models.Ticket, error) {
+	count := 0
+	var seatIDs []string
+	for _, selection := range selections {
+		count += selection.Quantity
+		seatIDs = append(seatIDs, selection.SeatIDs...)
+	}
+
+	event, err := reserveEventCapacity(tx, eventID, userID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seated events need a seat for every ticket, others can't take seats
+	if event.SeatMapID != nil {
+		for _, selection := range selections {
+			if len(selection.SeatIDs) != selection.Quantity {
+				return nil, types.ErrInvalidSeatSelection
+			}
+		}
+		if err := checkSeatSelection(tx, event, seatIDs); err != nil {
+			return nil, err
+		}
+	} else if len(seatIDs) > 0 {
+		return nil, types.ErrInvalidSeatSelection
+	}
+
+	userUUID, err := validateGormId(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var typesCount int64
+	if err := tx.Model(&models.TicketType{}).
+		Where("event_id = ?", eventID).
+		Count(&typesCount).Error; err != nil {
+		return nil, err
+	}
+
+	reservedAt := time.Now()
+	var tickets []models.Ticket
+	for _, selection := range selections {
+		ticket := models.Ticket{
+			EventID:    event.ID,
+			UserID:     userUUID,
+			Status:     values.TicketStatusReserved,
+			ReservedAt: reservedAt,
+			Price:      event.Price,
+		}
+
+		if selection.TicketTypeID != "" {
+			ticketType, err := reserveTicketTypeCapacity(tx, eventID, userID, selection.TicketTypeID, selection.Quantity)
+			if err != nil {
+				return nil, err
+			}
+			ticket.TicketTypeID = &ticketType.ID
+			ticket.Price = ticketType.Price
+		} else if typesCount > 0 {
+			// Events with tiers only sell through them
+			return nil, types.ErrTicketTypeRequired
+		}
+
+		for i := 0; i < selection.Quantity; i++ {
+			if event.SeatMapID != nil {
+				seatID, err := validateGormId(selection.SeatIDs[i])
+				if err != nil {
+					return nil, err
+				}
+				ticket.SeatID = &seatID
+			}
+			tickets = append(tickets, ticket)
+		}
+	}
+
+	if err := tx.Create(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+// reserveTicketTypeCapacity takes count places of a ticket type, checking its
+// sales window and per-user limit while the type row is locked.
+func reserveTicketTypeCapacity(tx *gorm.DB, eventID, userID, ticketTypeID string, count int) (*models.TicketType, error) {
+	var ticketType models.TicketType
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", ticketTypeID, eventID).
+		First(&ticketType).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrTicketTypeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if ticketType.SalesStartAt != nil && now.Before(*ticketType.SalesStartAt) {
+		return nil, types.ErrTicketTypeNotOnSale
+	}
+	if ticketType.SalesEndAt != nil && !now.Before(*ticketType.SalesEndAt) {
+		return nil, types.ErrTicketTypeNotOnSale
+	}
+
+	if ticketType.MaxPerUser > 0 {
+		var held int64
+		if err := tx.Model(&models.Ticket{}).
+			Where("ticket_type_id = ? AND user_id = ? AND status IN ?", ticketTypeID, userID,
+				[]string{values.TicketStatusReserved, values.TicketStatusPaid}).
+			Count(&held).Error; err != nil {
+			return nil, err
+		}
+		if int(held)+count > ticketType.MaxPerUser {
+			return nil, types.ErrTicketLimitExceeded
+		}
+	}
+
+	result := tx.Model(&ticketType).
+		Where("tickets_sold + ? <= capacity", count).
+		Update("tickets_sold", gorm.Expr("tickets_sold + ?", count))
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, types.ErrInsufficientTickets
+	}
+
+	return &ticketType, nil
+}
+
+// eventSalesCloseAt is when ticket sales of the event stop, its sales cutoff
+// after the start or the end of the event when it has none.
+func eventSalesCloseAt(event *models.Event) time.Time {
+	if event.SalesCutoffMinutes == nil {
+		return event.EndsAt
+	}
+
+	closeAt := event.StartsAt.Add(time.Duration(*event.SalesCutoffMinutes) * time.Minute)
+	if closeAt.After(event.EndsAt) {
+		return event.EndsAt
+	}
+	return closeAt
+}
+
+// reserveEventCapacity takes count places of the event for the user. The event
+// row stays locked until the transaction ends, so concurrent reservations for
+// the same event are serialized and can never push tickets_sold past capacity.
+func reserveEventCapacity(tx *gorm.DB, eventID, userID string, count int) (*models.Event, error) {
+	var event models.Event
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", eventID).
+		First(&event).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !entities.IsPublicEventStatus(event.Status) {
+		return nil, types.ErrEventNotActive
+	}
+	if !time.Now().Before(eventSalesCloseAt(&event)) {
+		return nil, types.ErrEventSalesClosed
+	}
+
+	// Events of a suspended organizer that already sold tickets stay visible, closed for sales.
+	// Suspending locks the events before it commits, so this read sees it once the lock is ours.
+	var suspended int64
+	if err := tx.Model(&models.User{}).
+		Where("id = ? AND status = ?", event.OrganizerID, values.UserStatusSuspended).
+		Count(&suspended).Error; err != nil {
+		return nil, err
+	}
+	if suspended > 0 {
+		return nil, types.ErrEventSalesClosed
+	}
+
+	var held int64
+	if err := tx.Model(&models.Ticket{}).
+		Where("event_id = ? AND user_id = ? AND status IN ?", eventID, userID,
+			[]string{values.TicketStatusReserved, values.TicketStatusPaid}).
+		Count(&held).Error; err != nil {
+		return nil, err
+	}
+	if int(held)+count > values.MaxTicketsPerPurchase {
+		return nil, types.ErrTicketLimitExceeded
+	}
+
+	// The condition keeps the counter within capacity even for writers
+	// that don't take the lock
+	result := tx.Model(&event).
+		Where("tickets_sold + ? <= capacity", count).
+		Update("tickets_sold", gorm.Expr("tickets_sold + ?", count))
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, types.ErrInsufficientTickets
+	}
+
+	return &event, nil
+}
+
+// cancelEventTickets cancels the reservations of an event and marks its paid tickets for a
+// refund, which the payments service then executes with the provider
+func cancelEventTickets(tx *gorm.DB, eventID string) error {
+	if err := tx.Model(&models.Ticket{}).
+		Where("event_id = ? AND status = ?", eventID, values.TicketStatusPaid).
+		Update("refund_pending", true).Error; err != nil {
+		return err
+	}
+
+	return cancelEventReservations(tx, eventID)
+}
+
+// cancelEventReservations cancels the tickets of the event that are reserved but not paid yet
+// and gives their places back
+func cancelEventReservations(tx *gorm.DB, eventID string) error {
+	var tickets []models.Ticket
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ? AND status = ?", eventID, values.TicketStatusReserved).
+		Find(&tickets).Error; err != nil {
+		return err
+	}
+
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	if err := tx.Model(&tickets).
+		Update("status", values.TicketStatusCancelled).Error; err != nil {
+		return err
+	}
+
+	// Checkouts of the cancelled tickets can't complete anymore, a charge that still
+	// comes in for them is refunded
+	if paymentIDs := ticketPaymentIDs(tickets); len(paymentIDs) > 0 {
+		if err := failPayments(tx, paymentIDs); err != nil {
+			return err
+		}
+	}
+
+	return releaseEventCapacity(tx, tickets)
+}
+
+// updateTicketPayment marks the ticket paid, as long as it's still reserved. A ticket that
+// expired or was cancelled gave its place back already.
+func updateTicketPayment(tx *gorm.DB, ticketID string, paidAt time.Time) error {
+	result := tx.Model(&models.Ticket{}).
+		Where("id = ? AND status = ?", ticketID, values.TicketStatusReserved).
+		Updates(map[string]interface{}{
+			"status":  values.TicketStatusPaid,
+			"paid_at": paidAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrTicketNotPayable
+	}
+	return nil
+}
+
+// ticketPaymentIDs returns the payments the tickets are being checked out with
+func ticketPaymentIDs(tickets []models.Ticket) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var paymentIDs []uuid.UUID
+	for _, ticket := range tickets {
+		if ticket.PaymentID != nil && !seen[*ticket.PaymentID] {
+			seen[*ticket.PaymentID] = true
+			paymentIDs = append(paymentIDs, *ticket.PaymentID)
+		}
+	}
+	return paymentIDs
+}
+
 // Helper functions for mapping between domain and GORM models
 func toDomainTickets(tickets []models.Ticket) []*entities.Ticket {
 	result := make([]*entities.Ticket, len(tickets))
@@ -283,13 +566,34 @@ func toDomainTickets(tickets []models.Ticket) []*entities.Ticket {
 }
 
 func toDomainTicket(ticketModel *models.Ticket) *entities.Ticket {
+	var paymentID string
+	if ticketModel.PaymentID != nil {
+		paymentID = ticketModel.PaymentID.String()
+	}
+
+	var ticketTypeID string
+	if ticketModel.TicketTypeID != nil {
+		ticketTypeID = ticketModel.TicketTypeID.String()
+	}
+	var seatID string
+	if ticketModel.SeatID != nil {
+		seatID = ticketModel.SeatID.String()
+	}
+
 	return &entities.Ticket{
-		ID:         ticketModel.ID.String(),
-		Status:     ticketModel.Status,
-		ReservedAt: ticketModel.ReservedAt,
-		PaidAt:     ticketModel.PaidAt,
-		Price:      ticketModel.Price,
-		CreatedAt:  ticketModel.CreatedAt,
+		ID:           ticketModel.ID.String(),
+		EventID:      ticketModel.EventID.String(),
+		UserID:       ticketModel.UserID.String(),
+		TicketTypeID: ticketTypeID,
+		SeatID:       seatID,
+		PaymentID:    paymentID,
+		Status:       ticketModel.Status,
+		ReservedAt:   ticketModel.ReservedAt,
+		PaidAt:       ticketModel.PaidAt,
+		Price:        ticketModel.Price,
+		CreatedAt:    ticketModel.CreatedAt,
+
+		RefundPending: ticketModel.RefundPending,
 	}
 }
 
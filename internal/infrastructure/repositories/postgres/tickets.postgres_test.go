@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+)
+
+func TestCreateTicketsParallelNeverOversells(t *testing.T) {
+	const (
+		capacity = 100
+		buyers   = 300
+	)
+
+	db := testDB(t)
+	repo := NewTicketsRepository(db)
+	event := createTestEvent(t, db, capacity)
+
+	// Every buyer is a different user so the per-user limit doesn't get in the way
+	users := make([]*models.User, buyers)
+	for i := range users {
+		users[i] = createTestUser(t, db, values.UserRole)
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		reserved   int
+		unexpected []error
+	)
+	start := make(chan struct{})
+	for _, user := range users {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			<-start
+
+			selections := []entities.TicketSelection{{Quantity: 1}}
+			_, err := repo.CreateTickets(context.Background(), event.ID.String(), userID, selections)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				reserved++
+			case !errors.Is(err, types.ErrInsufficientTickets):
+				unexpected = append(unexpected, err)
+			}
+		}(user.ID.String())
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range unexpected {
+		t.Errorf("unexpected reservation error: %s", err)
+	}
+	if reserved != capacity {
+		t.Errorf("%d reservations succeeded, want %d", reserved, capacity)
+	}
+
+	var stored models.Event
+	if err := db.First(&stored, "id = ?", event.ID).Error; err != nil {
+		t.Fatalf("reading event: %s", err)
+	}
+	if stored.TicketsSold != capacity {
+		t.Errorf("tickets_sold = %d, want %d", stored.TicketsSold, capacity)
+	}
+
+	var tickets int64
+	err := db.Model(&models.Ticket{}).
+		Where("event_id = ? AND status = ?", event.ID, values.TicketStatusReserved).
+		Count(&tickets).Error
+	if err != nil {
+		t.Fatalf("counting tickets: %s", err)
+	}
+	if tickets != capacity {
+		t.Errorf("%d reserved tickets stored, want %d", tickets, capacity)
+	}
+}
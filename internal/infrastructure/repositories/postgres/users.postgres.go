@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"ticket-booking-app-backend/internal/domain/entities"
 	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
 	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type usersRepository struct {
@@ -22,7 +25,12 @@ func NewUsersRepository(db *gorm.DB) *usersRepository {
 func (r *usersRepository) Create(ctx context.Context, role string, user *entities.User) error {
 	tempUser := toGormUser(user)
 	tempUser.Role = role
-	return r.db.WithContext(ctx).Create(&tempUser).Error
+	if err := r.db.WithContext(ctx).Create(&tempUser).Error; err != nil {
+		return err
+	}
+
+	user.ID = tempUser.ID.String()
+	return nil
 }
 
 func (r *usersRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
@@ -39,10 +47,199 @@ func (r *usersRepository) GetByEmail(ctx context.Context, email string) (*entiti
 	return &user, err
 }
 
-// Todo() Decide on need
-// func (r *userRepository) Update(ctx context.Context, user domain.User) error {
-//     return r.db.WithContext(ctx).Save(&user).Error
-// }
+func (r *usersRepository) GetByID(ctx context.Context, userID string) (*entities.User, error) {
+	var user entities.User
+
+	err := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		First(&user).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrUserNotFound
+	}
+
+	return &user, err
+}
+
+func (r *usersRepository) GetUsers(ctx context.Context, role, status string) ([]*entities.User, error) {
+	var users []models.User
+	query := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("role = ?", role)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Order("created_at ASC").Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomainUsers(users), nil
+}
+
+// UpdateOrganizerStatus changes the status of an organizer account. Suspending one
+// stops the sales of all their events, ongoing ones included, and cancels the
+// reservations not paid yet. Tickets already paid stay valid: the events that sold
+// them stay visible to their holders, closed for sales while the organizer is
+// suspended, and an admin who wants them refunded cancels those events. The other
+// events and the series go back to draft, and stay there when the organizer is
+// reinstated.
+//
+// Suspension follows the person, not the organization: everything the organizer created
+// is affected, whichever organization it belongs to, while the events and series
+// other approved members created stay on sale, even in an organization the suspended
+// organizer owns.
+func (r *usersRepository) UpdateOrganizerStatus(ctx context.Context, organizerID, status, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.User{}).
+			Where("id = ? AND role = ?", organizerID, values.OrganizerRole).
+			Updates(map[string]interface{}{"status": status, "status_reason": reason})
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrOrganizerNotFound
+		}
+
+		if status != values.UserStatusSuspended {
+			return nil
+		}
+
+		liveStatuses := []string{values.EventStatusPendingReview, values.EventStatusScheduled,
+			values.EventStatusPublished, values.EventStatusOngoing}
+
+		// Tickets are locked before their event, like the expiry job does, then the events
+		// keep new reservations out until the suspension is committed
+		var reserved []models.Ticket
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND event_id IN (?)", values.TicketStatusReserved,
+				tx.Session(&gorm.Session{NewDB: true}).Model(&models.Event{}).Select("id").Where("organizer_id = ?", organizerID)).
+			Find(&reserved).Error; err != nil {
+			return err
+		}
+
+		var events []models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("organizer_id = ? AND status IN ?", organizerID, liveStatuses).
+			Find(&events).Error; err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := cancelEventReservations(tx, event.ID.String()); err != nil {
+				return err
+			}
+		}
+
+		// Ongoing events and the published ones with paid tickets stay where their holders find them
+		if err := tx.Model(&models.Event{}).
+			Where("organizer_id = ? AND status IN ?", organizerID,
+				[]string{values.EventStatusPendingReview, values.EventStatusScheduled, values.EventStatusPublished}).
+			Where("NOT EXISTS (?)", tx.Session(&gorm.Session{NewDB: true}).Model(&models.Ticket{}).Select("1").
+				Where("tickets.event_id = events.id AND tickets.status = ?", values.TicketStatusPaid)).
+			Updates(map[string]interface{}{"status": values.EventStatusDraft, "publish_at": nil}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.EventSeries{}).
+			Where("organizer_id = ?", organizerID).
+			Update("status", values.EventStatusDraft).Error
+	})
+}
+
+func (r *usersRepository) UpdateRole(ctx context.Context, userID, role string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		Update("role", role)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *usersRepository) RevokeSessions(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.User{}).
+			Where("id = ?", userID).
+			Update("token_version", gorm.Expr("token_version + 1"))
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrUserNotFound
+		}
+
+		return tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", time.Now()).Error
+	})
+}
+
+func (r *usersRepository) Update(ctx context.Context, user *entities.User) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{"name": user.Name, "address": user.Address, "phone": user.Phone})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *usersRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		Update("password", passwordHash)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrUserNotFound
+	}
+	return nil
+}
+
+func toDomainUsers(users []models.User) []*entities.User {
+	result := make([]*entities.User, len(users))
+	for i := range users {
+		result[i] = toDomainUser(&users[i])
+	}
+	return result
+}
+
+// toDomainUser maps the GORM User model to the domain User entity.
+func toDomainUser(userModel *models.User) *entities.User {
+	return &entities.User{
+		ID:           userModel.ID.String(),
+		Role:         userModel.Role,
+		Name:         userModel.Name,
+		Address:      userModel.Address,
+		Email:        userModel.Email,
+		Phone:        userModel.Phone,
+		Password:     userModel.Password,
+		Status:       userModel.Status,
+		StatusReason: userModel.StatusReason,
+		TokenVersion: userModel.TokenVersion,
+		CreatedAt:    userModel.CreatedAt,
+
+		EmailVerifiedAt: userModel.EmailVerifiedAt,
+	}
+}
 
 // ToGormUser maps the domain User entity to the GORM User model.
 func toGormUser(user *entities.User) models.User {
@@ -52,5 +249,8 @@ func toGormUser(user *entities.User) models.User {
 		Password: user.Password,
 		Phone:    user.Phone,
 		Address:  user.Address,
+		Status:   user.Status,
+
+		EmailVerifiedAt: user.EmailVerifiedAt,
 	}
 }
\ No newline at end of file
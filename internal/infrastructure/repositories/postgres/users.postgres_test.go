@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+)
+
+func TestSuspendOrganizerStopsSales(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	sold := createTestEvent(t, db, 10)
+	unsold := &models.Event{
+		OrganizerID: sold.OrganizerID,
+		Title:       "Unsold test event",
+		StartsAt:    sold.StartsAt,
+		EndsAt:      sold.EndsAt,
+		Capacity:    10,
+		Price:       10,
+		Status:      values.EventStatusPublished,
+	}
+	if err := db.Create(unsold).Error; err != nil {
+		t.Fatalf("creating event: %s", err)
+	}
+	if err := db.Model(&models.User{}).Where("id = ?", sold.OrganizerID).
+		Update("status", values.UserStatusActive).Error; err != nil {
+		t.Fatalf("approving organizer: %s", err)
+	}
+	user := createTestUser(t, db, values.UserRole)
+
+	ticketsRepo := NewTicketsRepository(db)
+	tickets, err := ticketsRepo.CreateTickets(ctx, sold.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 2}})
+	if err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+	if _, err := ticketsRepo.CreateTickets(ctx, unsold.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 1}}); err != nil {
+		t.Fatalf("CreateTickets: %s", err)
+	}
+
+	paymentsRepo := NewPaymentsRepository(db)
+	payment := &entities.Payment{ProviderPaymentID: uuid.NewString(), Amount: 10, Currency: values.PaymentCurrency}
+	if err := paymentsRepo.CreatePayment(ctx, user.ID.String(), []string{tickets[0].ID}, payment); err != nil {
+		t.Fatalf("CreatePayment: %s", err)
+	}
+	webhookEvent := &entities.PaymentWebhookEvent{
+		ID:                uuid.NewString(),
+		Type:              payments.EventPaymentSucceeded,
+		ProviderPaymentID: payment.ProviderPaymentID,
+	}
+	if err := paymentsRepo.CompletePayment(ctx, payment.ID, time.Now(), webhookEvent); err != nil {
+		t.Fatalf("CompletePayment: %s", err)
+	}
+
+	repo := NewUsersRepository(db)
+	if err := repo.UpdateOrganizerStatus(ctx, sold.OrganizerID.String(), values.UserStatusSuspended, "test"); err != nil {
+		t.Fatalf("UpdateOrganizerStatus: %s", err)
+	}
+
+	// The event with a paid ticket stays visible with only that ticket sold, the other goes back to draft
+	want := map[string]struct {
+		status string
+		sold   int
+	}{
+		sold.ID.String():   {values.EventStatusPublished, 1},
+		unsold.ID.String(): {values.EventStatusDraft, 0},
+	}
+	for id, want := range want {
+		var stored models.Event
+		if err := db.First(&stored, "id = ?", id).Error; err != nil {
+			t.Fatalf("reading event: %s", err)
+		}
+		if stored.Status != want.status || stored.TicketsSold != want.sold {
+			t.Errorf("event %s status = %q with %d sold, want %q with %d", id, stored.Status, stored.TicketsSold, want.status, want.sold)
+		}
+	}
+
+	var reserved int64
+	if err := db.Model(&models.Ticket{}).
+		Where("event_id IN ? AND status = ?", []string{sold.ID.String(), unsold.ID.String()}, values.TicketStatusReserved).
+		Count(&reserved).Error; err != nil {
+		t.Fatalf("counting tickets: %s", err)
+	}
+	if reserved != 0 {
+		t.Errorf("%d tickets still reserved", reserved)
+	}
+
+	_, err = ticketsRepo.CreateTickets(ctx, sold.ID.String(), user.ID.String(), []entities.TicketSelection{{Quantity: 1}})
+	if !errors.Is(err, types.ErrEventSalesClosed) {
+		t.Fatalf("CreateTickets error = %v, want %v", err, types.ErrEventSalesClosed)
+	}
+
+	// Reinstating the organizer opens the sales of the visible event again
+	if err := repo.UpdateOrganizerStatus(ctx, sold.OrganizerID.String(), values.UserStatusActive, ""); err != nil {
+		t.Fatalf("UpdateOrganizerStatus: %s", err)
+	}
+	if _, err := ticketsRepo.CreateTickets(ctx, sold.ID.String(), user.ID.String(),
+		[]entities.TicketSelection{{Quantity: 1}}); err != nil {
+		t.Errorf("CreateTickets after reinstating: %s", err)
+	}
+}
@@ -0,0 +1,210 @@
+// infrastructure/repositories/postgres/venues.postgres.go
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"ticket-booking-app-backend/internal/domain/entities"
+	"ticket-booking-app-backend/internal/infrastructure/drivers/postgres/models"
+	"ticket-booking-app-backend/internal/infrastructure/types"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// venueEditableColumns are written on update, so cleared coordinates are saved too.
+var venueEditableColumns = []string{
+	"name", "address", "city", "country", "latitude", "longitude", "timezone", "default_capacity",
+}
+
+type venuesRepository struct {
+	db *gorm.DB
+}
+
+func NewVenuesRepository(db *gorm.DB) *venuesRepository {
+	return &venuesRepository{db: db}
+}
+
+func (r *venuesRepository) CreateVenue(ctx context.Context, venue *entities.Venue) error {
+	gormVenue, err := toGormVenue(venue)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Create(gormVenue).Error; err != nil {
+		return err
+	}
+
+	*venue = *toDomainVenue(gormVenue)
+	return nil
+}
+
+func (r *venuesRepository) GetVenueByID(ctx context.Context, venueID string) (*entities.Venue, error) {
+	var venue models.Venue
+	err := r.db.WithContext(ctx).
+		Where("id = ?", venueID).
+		First(&venue).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrVenueNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVenue(&venue), nil
+}
+
+func (r *venuesRepository) GetVenues(ctx context.Context, city, country string) ([]*entities.Venue, error) {
+	var venues []models.Venue
+	query := r.db.WithContext(ctx)
+
+	if city != "" {
+		query = query.Where("LOWER(city) = LOWER(?)", city)
+	}
+	if country != "" {
+		query = query.Where("LOWER(country) = LOWER(?)", country)
+	}
+
+	if err := query.Order("name ASC").Find(&venues).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.Venue, len(venues))
+	for i, venue := range venues {
+		result[i] = toDomainVenue(&venue)
+	}
+	return result, nil
+}
+
+func (r *venuesRepository) UpdateVenue(ctx context.Context, venue *entities.Venue) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.Venue
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", venue.ID).
+			First(&existing).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrVenueNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		gormVenue, err := toGormVenue(venue)
+		if err != nil {
+			return err
+		}
+
+		// Upcoming events must still fit into the venue
+		var largest int64
+		if err := tx.Model(&models.Event{}).
+			Select("COALESCE(MAX(capacity), 0)").
+			Where("venue_id = ? AND status IN ?", venue.ID,
+				[]string{values.EventStatusDraft, values.EventStatusPendingReview, values.EventStatusScheduled, values.EventStatusPublished, values.EventStatusOngoing}).
+			Scan(&largest).Error; err != nil {
+			return err
+		}
+		if int(largest) > gormVenue.DefaultCapacity {
+			return types.ErrVenueCapacityExceeded
+		}
+
+		if err := tx.Model(&existing).
+			Select(venueEditableColumns).
+			Updates(gormVenue).Error; err != nil {
+			return err
+		}
+
+		gormVenue.OrganizerID = existing.OrganizerID
+		gormVenue.CreatedAt = existing.CreatedAt
+		*venue = *toDomainVenue(gormVenue)
+		return nil
+	})
+}
+
+func (r *venuesRepository) DeleteVenue(ctx context.Context, venueID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Event{}).
+			Where("venue_id = ?", venueID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return types.ErrVenueInUse
+		}
+
+		result := tx.Where("id = ?", venueID).Delete(&models.Venue{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return types.ErrVenueNotFound
+		}
+		return nil
+	})
+}
+
+func (r *venuesRepository) ValidateVenueOwnership(ctx context.Context, venueID, organizerID string) error {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Venue{}).
+		Where("id = ? AND organizer_id = ?", venueID, organizerID).
+		Count(&count).Error
+
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrVenueNotFound
+	}
+	return nil
+}
+
+// Helper functions for mapping between domain and GORM models
+func toDomainVenue(venueModel *models.Venue) *entities.Venue {
+	return &entities.Venue{
+		ID:              venueModel.ID.String(),
+		OrganizerID:     venueModel.OrganizerID.String(),
+		Name:            venueModel.Name,
+		Address:         venueModel.Address,
+		City:            venueModel.City,
+		Country:         venueModel.Country,
+		Latitude:        venueModel.Latitude,
+		Longitude:       venueModel.Longitude,
+		Timezone:        venueModel.Timezone,
+		DefaultCapacity: venueModel.DefaultCapacity,
+		CreatedAt:       venueModel.CreatedAt,
+	}
+}
+
+func toGormVenue(venue *entities.Venue) (*models.Venue, error) {
+	var venueID uuid.UUID
+	var err error
+	if venue.ID != "" {
+		venueID, err = validateGormId(venue.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	organizerID, err := validateGormId(venue.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Venue{
+		ID:              venueID,
+		OrganizerID:     organizerID,
+		Name:            venue.Name,
+		Address:         venue.Address,
+		City:            venue.City,
+		Country:         venue.Country,
+		Latitude:        venue.Latitude,
+		Longitude:       venue.Longitude,
+		Timezone:        venue.Timezone,
+		DefaultCapacity: venue.DefaultCapacity,
+	}, nil
+}
@@ -1,15 +1,104 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+)
 
 
 var (
-	ErrUserNotFound = errors.New("user doesn't exists")
-	ErrEventNotFound = errors.New("event doesn't exists")
+	ErrUserNotFound = domainErrors.ErrUserNotFound
+	ErrEventNotFound = domainErrors.ErrEventNotFound
+	ErrInvalidEventTransition = domainErrors.ErrInvalidEventTransition
+	ErrEventHasTickets = domainErrors.ErrEventHasTickets
+	ErrEventNotPendingReview = domainErrors.ErrEventNotPendingReview
+	ErrEventCapacityBelowSold = domainErrors.ErrEventCapacityBelowSold
+	ErrEventSeriesNotFound = domainErrors.ErrEventSeriesNotFound
 	ErrInvalidUUID = errors.New("invalid UUID")
 )
 
 var (
-	ErrTicketNotFound = errors.New("ticket not found")
-	ErrTicketLimitExceeded = errors.New("ticket limit exceeded")
-)
\ No newline at end of file
+	ErrRefreshTokenInvalid = domainErrors.ErrRefreshTokenInvalid
+	ErrRefreshTokenReused  = domainErrors.ErrRefreshTokenReused
+)
+
+var (
+	ErrEmailNotVerified        = domainErrors.ErrEmailNotVerified
+	ErrEmailAlreadyVerified    = domainErrors.ErrEmailAlreadyVerified
+	ErrVerificationCodeInvalid = domainErrors.ErrVerificationCodeInvalid
+)
+
+var (
+	ErrPasswordResetTokenInvalid = domainErrors.ErrPasswordResetTokenInvalid
+)
+
+var (
+	ErrOrganizerNotFound    = domainErrors.ErrOrganizerNotFound
+	ErrOrganizerNotApproved = domainErrors.ErrOrganizerNotApproved
+)
+
+var (
+	ErrOrganizationNotFound       = domainErrors.ErrOrganizationNotFound
+	ErrOrganizationMemberNotFound = domainErrors.ErrOrganizationMemberNotFound
+	ErrOrganizationMemberExists   = domainErrors.ErrOrganizationMemberExists
+	ErrInvitationNotFound         = domainErrors.ErrInvitationNotFound
+	ErrInvitationExpired          = domainErrors.ErrInvitationExpired
+)
+
+var (
+	ErrRoleNotFound = domainErrors.ErrRoleNotFound
+	ErrRoleExists   = domainErrors.ErrRoleExists
+	ErrRoleInUse    = domainErrors.ErrRoleInUse
+)
+
+var (
+	ErrTicketNotFound = domainErrors.ErrTicketNotFound
+	ErrTicketLimitExceeded = domainErrors.ErrTicketLimitExceeded
+	ErrInsufficientTickets = domainErrors.ErrInsufficientTickets
+	ErrEventNotActive = domainErrors.ErrEventNotActive
+	ErrEventSalesClosed = domainErrors.ErrEventSalesClosed
+	ErrInvalidTicketStatus = domainErrors.ErrInvalidTicketStatus
+)
+
+var (
+	ErrTicketTypeNotFound         = domainErrors.ErrTicketTypeNotFound
+	ErrTicketTypeNotOnSale        = domainErrors.ErrTicketTypeNotOnSale
+	ErrTicketTypeRequired         = domainErrors.ErrTicketTypeRequired
+	ErrTicketTypeInUse            = domainErrors.ErrTicketTypeInUse
+	ErrTicketTypeCapacityExceeded = domainErrors.ErrTicketTypeCapacityExceeded
+)
+
+var (
+	ErrCategoryNotFound = domainErrors.ErrCategoryNotFound
+	ErrCategoryExists   = domainErrors.ErrCategoryExists
+	ErrCategoryInUse    = domainErrors.ErrCategoryInUse
+)
+
+var (
+	ErrVenueNotFound = domainErrors.ErrVenueNotFound
+	ErrVenueInUse    = domainErrors.ErrVenueInUse
+
+	ErrVenueCapacityExceeded = domainErrors.ErrVenueCapacityExceeded
+)
+
+var (
+	ErrSeatMapNotFound      = domainErrors.ErrSeatMapNotFound
+	ErrSeatNotAvailable     = domainErrors.ErrSeatNotAvailable
+	ErrInvalidSeatSelection = domainErrors.ErrInvalidSeatSelection
+	ErrSeatMapLocked        = domainErrors.ErrSeatMapLocked
+	ErrSeatMapVenueMismatch = domainErrors.ErrSeatMapVenueMismatch
+)
+
+// Errors shared with the domain layer so callers can match them with errors.Is.
+var (
+	ErrPaymentNotFound  = domainErrors.ErrPaymentNotFound
+	ErrTicketNotPayable = domainErrors.ErrTicketNotPayable
+	ErrPaymentReleased  = domainErrors.ErrPaymentReleased
+	ErrWebhookProcessed = domainErrors.ErrWebhookProcessed
+)
+
+var (
+	ErrRefundNotFound         = domainErrors.ErrRefundNotFound
+	ErrRefundAlreadyRequested = domainErrors.ErrRefundAlreadyRequested
+)
@@ -0,0 +1,214 @@
+// internal/application/handlers/categories.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initCategoriesRoutes initializes the category routes
+func (h *Handler) initCategoriesRoutes(api *gin.RouterGroup) {
+	categories := api.Group("/categories", h.authMiddleware.UserIdentity)
+	{
+		// Public routes
+		categories.GET("", h.getCategories)
+
+		// Admin routes
+		categories.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceCategories, values.ActionCreate, values.ScopeAny), h.createCategory)
+		categories.PUT("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceCategories, values.ActionUpdate, values.ScopeAny), h.updateCategory)
+		categories.DELETE("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceCategories, values.ActionDelete, values.ScopeAny), h.deleteCategory)
+	}
+}
+
+// @Summary List Categories
+// @Tags categories
+// @Description Get all event categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Category
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/categories [get]
+func (h *Handler) getCategories(c *gin.Context) {
+	categories, err := h.services.Categories.GetCategories(c.Request.Context())
+	if err != nil {
+		logrus.Errorf("Error getting categories: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+// @Summary Create Category
+// @Tags categories
+// @Description Add an event category (admin only)
+// @Accept json
+// @Produce json
+// @Param input body requests.CategoryRequestBody true "Category data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Category
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/categories [post]
+func (h *Handler) createCategory(c *gin.Context) {
+	var inp requests.CreateCategoryRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+	inp.Role = role
+
+	category, err := h.services.Categories.CreateCategory(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidCategoryName) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryExists) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating category: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+// @Summary Update Category
+// @Tags categories
+// @Description Rename an event category (admin only)
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param input body requests.CategoryRequestBody true "Category data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Category
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/categories/{id} [put]
+func (h *Handler) updateCategory(c *gin.Context) {
+	var inp requests.UpdateCategoryRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	categoryID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = categoryID
+	inp.Role = role
+
+	category, err := h.services.Categories.UpdateCategory(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidCategoryName) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryExists) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error updating category: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Delete Category
+// @Tags categories
+// @Description Delete an event category no event is assigned to (admin only)
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/categories/{id} [delete]
+func (h *Handler) deleteCategory(c *gin.Context) {
+	categoryID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.DeleteCategoryRequest{
+		ID:   categoryID,
+		Role: role,
+	}
+
+	if err := h.services.Categories.DeleteCategory(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrCategoryInUse) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error deleting category: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("category deleted successfully"))
+}
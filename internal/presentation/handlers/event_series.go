@@ -0,0 +1,322 @@
+// internal/application/handlers/event_series.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initEventSeriesRoutes initializes the event series routes, occurrences are managed through the event routes
+func (h *Handler) initEventSeriesRoutes(api *gin.RouterGroup) {
+	series := api.Group("/event-series", h.authMiddleware.UserIdentity)
+	{
+		series.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceEventSeries, values.ActionCreate, values.ScopeOwn), h.createEventSeries)
+		series.GET("", h.authMiddleware.PermissionMiddleware(values.ResourceEventSeries, values.ActionRead, values.ScopeOwn), h.getEventSeriesByOrganizer)
+		series.GET("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceEventSeries, values.ActionRead, values.ScopeOwn), h.getEventSeriesByID)
+		series.PUT("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceEventSeries, values.ActionUpdate, values.ScopeOwn), h.updateEventSeries)
+		series.PUT("/:id/publish", h.authMiddleware.PermissionMiddleware(values.ResourceEventSeries, values.ActionPublish, values.ScopeOwn), h.publishEventSeries)
+	}
+}
+
+// @Summary Create Event Series
+// @Tags event-series
+// @Description Create a recurring event, its occurrences are created as draft events for the coming days
+// @Accept json
+// @Produce json
+// @Param input body requests.EventSeriesRequestBody true "Event series data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.EventSeries
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/event-series [post]
+func (h *Handler) createEventSeries(c *gin.Context) {
+	var inp requests.CreateEventSeriesRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	series, err := h.services.EventSeries.CreateSeries(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidRecurrence) ||
+			errors.Is(err, domainErrors.ErrInvalidTimezone) ||
+			errors.Is(err, domainErrors.ErrVenueCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventDateInvalid) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) ||
+			errors.Is(err, domainErrors.ErrOrganizerNotApproved) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization not found")
+			return
+		}
+		logrus.Errorf("Error creating event series: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, series)
+}
+
+// @Summary List Organizer Event Series
+// @Tags event-series
+// @Description Get the event series of the current organizer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.EventSeries
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/event-series [get]
+func (h *Handler) getEventSeriesByOrganizer(c *gin.Context) {
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventSeriesByOrganizerRequest{
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	series, err := h.services.EventSeries.GetSeriesByOrganizer(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting event series: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// @Summary Get Event Series
+// @Tags event-series
+// @Description Get an event series with its upcoming occurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "Event series ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.EventSeries
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/event-series/{id} [get]
+func (h *Handler) getEventSeriesByID(c *gin.Context) {
+	seriesID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventSeriesRequest{
+		ID:          seriesID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	series, err := h.services.EventSeries.GetSeriesByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventSeriesNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event series not found")
+			return
+		}
+		logrus.Errorf("Error getting event series: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// @Summary Update Event Series
+// @Tags event-series
+// @Description Update an event series. The changes carry over to its upcoming occurrences, which move to the
+// @Description new schedule by day or position in the rule. Occurrences dropped from it are removed unless tickets were sold for them. With moderation on, organizers'
+// @Description changes to the title, description, location or dates take the live series and occurrences back to review
+// @Accept json
+// @Produce json
+// @Param id path string true "Event series ID"
+// @Param input body requests.EventSeriesRequestBody true "Event series data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.EventSeries
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/event-series/{id} [put]
+func (h *Handler) updateEventSeries(c *gin.Context) {
+	seriesID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.UpdateEventSeriesRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = seriesID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	series, err := h.services.EventSeries.UpdateSeries(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidRecurrence) ||
+			errors.Is(err, domainErrors.ErrInvalidTimezone) ||
+			errors.Is(err, domainErrors.ErrVenueCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventCapacityBelowSold) ||
+			errors.Is(err, domainErrors.ErrTicketTypeCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventDateInvalid) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventSeriesNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event series not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		logrus.Errorf("Error updating event series: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// @Summary Publish Event Series
+// @Tags event-series
+// @Description Publish an event series with its upcoming draft and pending review occurrences, later occurrences are created published
+// @Accept json
+// @Produce json
+// @Param id path string true "Event series ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.EventSeries
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/event-series/{id}/publish [put]
+func (h *Handler) publishEventSeries(c *gin.Context) {
+	seriesID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.PublishEventSeriesRequest{
+		ID:          seriesID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	series, err := h.services.EventSeries.PublishSeries(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) ||
+			errors.Is(err, domainErrors.ErrOrganizerNotApproved) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventSeriesNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event series not found")
+			return
+		}
+		logrus.Errorf("Error publishing event series: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
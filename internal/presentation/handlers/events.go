@@ -4,9 +4,12 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
+	types "ticket-booking-app-backend/internal/application/types/errors"
 	"ticket-booking-app-backend/internal/application/types/requests"
+	"ticket-booking-app-backend/internal/domain/entities"
 	domainErrors "ticket-booking-app-backend/internal/domain/types"
 	"ticket-booking-app-backend/internal/helpers"
 	"ticket-booking-app-backend/pkg/values"
@@ -20,34 +23,65 @@ func (h *Handler) initEventsRoutes(api *gin.RouterGroup) {
 	events := api.Group("/events", h.authMiddleware.UserIdentity)
 	{
 		// Public routes
-		events.GET("/", h.getActiveEvents) // For all users to see active events
+		events.GET("/", h.getActiveEvents)                     // For all users to see published events
+		events.GET("/nearby", h.getNearbyEvents)               // Published events around a coordinate
+		events.GET("/:id/ticket-types", h.getEventTicketTypes) // Ticket types on sale for an event
+		events.GET("/:id/seats", h.getEventSeats)              // Seat availability of a seated event
+		events.GET("/:id", h.getEventByID)                     // Published events, or unpublished ones the user may see
 
 		// Protected routes
 		// Organizer routes
-		organizer := events.Group("/organizer", h.authMiddleware.RoleMiddleware(values.OrganizerRole, values.AdminRole))
+		organizer := events.Group("/organizer")
 		{
-			organizer.GET("/", h.getOrganizerEvents)    // Get organizer's own events
-			organizer.POST("/", h.createEvent)          // Create new event
-			organizer.PUT("/:id", h.updateEvent)        // Update own event
-			organizer.DELETE("/:id", h.deleteEvent)     // Delete own event
-			organizer.PUT("/cancel/:id", h.cancelEvent) // Cancel own event
+			organizer.GET("/", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionRead, values.ScopeOwn), h.getOrganizerEvents)          // Get organizer's own events
+			organizer.POST("/", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionCreate, values.ScopeOwn), h.createEvent)              // Create new event
+			organizer.PUT("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionUpdate, values.ScopeOwn), h.updateEvent)            // Update own event
+			organizer.DELETE("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionDelete, values.ScopeOwn), h.deleteEvent)         // Delete own event
+			organizer.PUT("/cancel/:id", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionCancel, values.ScopeOwn), h.cancelEvent)     // Cancel own event
+			organizer.PUT("/:id/publish", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionPublish, values.ScopeOwn), h.publishEvent)  // Publish own event now or at publish_at
+			organizer.GET("/:id/refunds", h.authMiddleware.PermissionMiddleware(values.ResourceRefunds, values.ActionRead, values.ScopeOwn), h.getEventRefunds) // Refund results of own event
+			organizer.GET("/:id/reviews", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionRead, values.ScopeOwn), h.getEventReviews)  // Moderation history of own event
+
+			// Ticket types of own event
+			organizer.POST("/:id/ticket-types", h.authMiddleware.PermissionMiddleware(values.ResourceTicketTypes, values.ActionCreate, values.ScopeOwn), h.createTicketType)
+			organizer.PUT("/:id/ticket-types/:typeId", h.authMiddleware.PermissionMiddleware(values.ResourceTicketTypes, values.ActionUpdate, values.ScopeOwn), h.updateTicketType)
+			organizer.DELETE("/:id/ticket-types/:typeId", h.authMiddleware.PermissionMiddleware(values.ResourceTicketTypes, values.ActionDelete, values.ScopeOwn), h.deleteTicketType)
+
+			organizer.PUT("/:id/seat-map", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionUpdate, values.ScopeOwn), h.attachSeatMap) // Switch own event to reserved seating
 		}
 
 		// Admin routes
-		admin := events.Group("/admin", h.authMiddleware.RoleMiddleware(values.AdminRole))
+		admin := events.Group("/admin")
 		{
-			admin.GET("/", h.getAllEvents) // Get all events
+			admin.GET("/", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionRead, values.ScopeAny), h.getAllEvents)                // Get all events
+			admin.GET("/:id/refunds", h.authMiddleware.PermissionMiddleware(values.ResourceRefunds, values.ActionRead, values.ScopeAny), h.getEventRefunds) // Refund results of any event
+			admin.GET("/:id/reviews", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionRead, values.ScopeAny), h.getEventReviews)  // Moderation history of any event
+			admin.PUT("/:id/approve", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionReview, values.ScopeAny), h.approveEvent)   // Let an event waiting for review go live
+			admin.PUT("/:id/reject", h.authMiddleware.PermissionMiddleware(values.ResourceEvents, values.ActionReview, values.ScopeAny), h.rejectEvent)     // Send an event waiting for review back to its organizer
 		}
 	}
 }
 
 // @Summary List Active Events
 // @Tags events
-// @Description Get a list of all active events
+// @Description Search, filter, sort and page through published events
 // @Accept json
 // @Produce json
+// @Param q query string false "Full-text and typo-tolerant search in title and description"
+// @Param location query string false "Location contains"
+// @Param venue_id query string false "Venue ID"
+// @Param category_id query string false "Category ID"
+// @Param tag query []string false "Tags the events must all carry" collectionFormat(multi)
+// @Param date_from query string false "Events starting on or after (RFC 3339)"
+// @Param date_to query string false "Events starting on or before (RFC 3339)"
+// @Param min_price query number false "Minimum price"
+// @Param max_price query number false "Maximum price"
+// @Param sort query string false "Sort field, prefix with - for descending (relevance/date/price/title/created_at), searches default to relevance"
+// @Param limit query int false "Page size (max 100)"
+// @Param offset query int false "Number of events to skip"
 // @Security ApiKeyAuth
-// @Success 200 {array} entities.Event
+// @Success 200 {object} responses.EventsPage
+// @Failure 400 {object} helpers.Response
 // @Failure 401 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
 // @Router /api/v1/events [get]
@@ -59,35 +93,87 @@ func (h *Handler) getActiveEvents(c *gin.Context) {
 	}
 
 	inp := requests.GetEventsRequest{
-		Role:   role,
-		Status: values.EventStatusActive,
+		Role:     role,
+		Statuses: entities.PublicEventStatuses,
+	}
+	if err := c.ShouldBindQuery(&inp.Query); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid query: "+err.Error())
+		return
 	}
 
-	events, err := h.services.Events.GetEvents(c.Request.Context(), &inp)
+	page, err := h.services.Events.GetEvents(c.Request.Context(), &inp)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidEventFilter) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		logrus.Errorf("Error getting active events: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, events)
+	page.Next = nextPageLink(c, page.Limit, page.Offset, page.Total)
+	c.JSON(http.StatusOK, page)
 }
 
-// @Summary List Organizer Events
+// @Summary List Nearby Events
+// @Tags events
+// @Description Get published events whose venue lies within a radius of a coordinate, closest first
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius query number false "Radius in kilometers (default 10, max 500)"
+// @Param limit query int false "Page size (max 100)"
+// @Param offset query int false "Number of events to skip"
+// @Security ApiKeyAuth
+// @Success 200 {object} responses.NearbyEventsPage
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/nearby [get]
+func (h *Handler) getNearbyEvents(c *gin.Context) {
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetNearbyEventsRequest{
+		Role: role,
+	}
+	if err := c.ShouldBindQuery(&inp.Query); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid query: "+err.Error())
+		return
+	}
+
+	page, err := h.services.Events.GetNearbyEvents(c.Request.Context(), &inp)
+	if err != nil {
+		logrus.Errorf("Error getting nearby events: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page.Next = nextPageLink(c, page.Limit, page.Offset, page.Total)
+	c.JSON(http.StatusOK, page)
+}
+
+// @Summary Get Event
 // @Tags events
-// @Description Get list of events for authenticated organizer
+// @Description Get an event, unpublished ones only for the users allowed to see them
 // @Accept json
 // @Produce json
-// @Param status query string false "Event status filter (active/cancelled/finished)"
+// @Param id path string true "Event ID"
 // @Security ApiKeyAuth
-// @Success 200 {array} entities.Event
+// @Success 200 {object} entities.Event
 // @Failure 400 {object} helpers.Response
 // @Failure 401 {object} helpers.Response
 // @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
-// @Router /api/v1/events/organizer [get]
-func (h *Handler) getOrganizerEvents(c *gin.Context) {
-	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+// @Router /api/v1/events/{id} [get]
+func (h *Handler) getEventByID(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
 	if err != nil {
 		return
 	}
@@ -96,7 +182,62 @@ func (h *Handler) getOrganizerEvents(c *gin.Context) {
 		logrus.Warn("Error getting role from context")
 		return
 	}
-	status, err := h.validateQueryParam(c, values.StatusQueryParam)
+
+	inp := requests.GetEventByIDRequest{
+		ID:          eventID,
+		OrganizerID: c.GetString(values.UserIdCtx),
+		Role:        role,
+	}
+
+	event, err := h.services.Events.GetEventByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting event: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// @Summary List Organizer Events
+// @Tags events
+// @Description Search, filter, sort and page through the authenticated organizer's events
+// @Accept json
+// @Produce json
+// @Param status query string false "Event status filter (draft/scheduled/published/ongoing/finished/cancelled)"
+// @Param q query string false "Full-text and typo-tolerant search in title and description"
+// @Param location query string false "Location contains"
+// @Param venue_id query string false "Venue ID"
+// @Param category_id query string false "Category ID"
+// @Param tag query []string false "Tags the events must all carry" collectionFormat(multi)
+// @Param date_from query string false "Events starting on or after (RFC 3339)"
+// @Param date_to query string false "Events starting on or before (RFC 3339)"
+// @Param min_price query number false "Minimum price"
+// @Param max_price query number false "Maximum price"
+// @Param sort query string false "Sort field, prefix with - for descending (relevance/date/price/title/created_at), searches default to relevance"
+// @Param limit query int false "Page size (max 100)"
+// @Param offset query int false "Number of events to skip"
+// @Security ApiKeyAuth
+// @Success 200 {object} responses.EventsPage
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer [get]
+func (h *Handler) getOrganizerEvents(c *gin.Context) {
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
 	if err != nil {
 		logrus.Warn("Error getting role from context")
 		return
@@ -105,17 +246,30 @@ func (h *Handler) getOrganizerEvents(c *gin.Context) {
 	inp := requests.GetEventsByOrganizerRequest{
 		OrganizerID: organizerID,
 		Role:        role,
-		Status:      status,
+		Status:      c.Query(values.StatusQueryParam),
+	}
+	if err := c.ShouldBindQuery(&inp.Query); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid query: "+err.Error())
+		return
 	}
 
-	events, err := h.services.Events.GetEventsByOrganizer(c.Request.Context(), &inp)
+	page, err := h.services.Events.GetEventsByOrganizer(c.Request.Context(), &inp)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidEventFilter) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error getting organizer events: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, events)
+	page.Next = nextPageLink(c, page.Limit, page.Offset, page.Total)
+	c.JSON(http.StatusOK, page)
 }
 
 // @Summary Create Event
@@ -154,6 +308,28 @@ func (h *Handler) createEvent(c *gin.Context) {
 
 	err = h.services.Events.CreateEvent(c.Request.Context(), &inp)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrVenueCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventDateInvalid) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) ||
+			errors.Is(err, domainErrors.ErrOrganizerNotApproved) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error creating event: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -208,6 +384,26 @@ func (h *Handler) updateEvent(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
 			return
 		}
+		if errors.Is(err, domainErrors.ErrVenueCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventCapacityBelowSold) ||
+			errors.Is(err, domainErrors.ErrTicketTypeCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrEventDateInvalid) ||
+			errors.Is(err, domainErrors.ErrInvalidEventTransition) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrCategoryNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "category not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error updating event: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -218,7 +414,7 @@ func (h *Handler) updateEvent(c *gin.Context) {
 
 // @Summary Delete Event
 // @Tags events
-// @Description Delete an event
+// @Description Delete an event. Events with reserved or paid tickets have to be cancelled instead, which refunds them
 // @Accept json
 // @Produce json
 // @Param id path string true "Event ID"
@@ -228,6 +424,7 @@ func (h *Handler) updateEvent(c *gin.Context) {
 // @Failure 401 {object} helpers.Response
 // @Failure 403 {object} helpers.Response
 // @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
 // @Router /api/v1/events/organizer/{id} [delete]
 func (h *Handler) deleteEvent(c *gin.Context) {
@@ -236,10 +433,16 @@ func (h *Handler) deleteEvent(c *gin.Context) {
 		return
 	}
 
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
 	inp := requests.DeleteEventRequest{
 		ID:          eventID,
 		OrganizerID: c.GetString(values.UserIdCtx),
-		Role:        values.OrganizerRole,
+		Role:        role,
 	}
 
 	if err := h.services.Events.DeleteEvent(c.Request.Context(), &inp); err != nil {
@@ -247,6 +450,15 @@ func (h *Handler) deleteEvent(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
 			return
 		}
+		if errors.Is(err, domainErrors.ErrEventAlreadyFinished) ||
+			errors.Is(err, domainErrors.ErrEventHasTickets) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error deleting event: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -257,36 +469,60 @@ func (h *Handler) deleteEvent(c *gin.Context) {
 
 // @Summary List All Events
 // @Tags events
-// @Description Get all events (admin only)
+// @Description Search, filter, sort and page through all events (admin only)
 // @Accept json
 // @Produce json
-// @Param status query string false "Event status filter (active/cancelled/finished)"
+// @Param status query string false "Event status filter (draft/scheduled/published/ongoing/finished/cancelled)"
+// @Param q query string false "Full-text and typo-tolerant search in title and description"
+// @Param location query string false "Location contains"
+// @Param venue_id query string false "Venue ID"
+// @Param category_id query string false "Category ID"
+// @Param tag query []string false "Tags the events must all carry" collectionFormat(multi)
+// @Param date_from query string false "Events starting on or after (RFC 3339)"
+// @Param date_to query string false "Events starting on or before (RFC 3339)"
+// @Param min_price query number false "Minimum price"
+// @Param max_price query number false "Maximum price"
+// @Param sort query string false "Sort field, prefix with - for descending (relevance/date/price/title/created_at), searches default to relevance"
+// @Param limit query int false "Page size (max 100)"
+// @Param offset query int false "Number of events to skip"
 // @Security ApiKeyAuth
-// @Success 200 {array} entities.Event
+// @Success 200 {object} responses.EventsPage
+// @Failure 400 {object} helpers.Response
 // @Failure 401 {object} helpers.Response
 // @Failure 403 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
 // @Router /api/v1/events/admin [get]
 func (h *Handler) getAllEvents(c *gin.Context) {
-	status, err := h.validateQueryParam(c, values.StatusQueryParam)
+	role, err := h.validateContextKey(c, values.RoleCtx)
 	if err != nil {
-		logrus.Warn("Error getting status from query")
+		logrus.Warn("Error getting role from context")
 		return
 	}
 
 	inp := requests.GetEventsRequest{
-		Role:   values.AdminRole,
-		Status: status,
+		Role: role,
+	}
+	if status := c.Query(values.StatusQueryParam); status != "" {
+		inp.Statuses = []string{status}
+	}
+	if err := c.ShouldBindQuery(&inp.Query); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid query: "+err.Error())
+		return
 	}
 
-	events, err := h.services.Events.GetEvents(c.Request.Context(), &inp)
+	page, err := h.services.Events.GetEvents(c.Request.Context(), &inp)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidEventFilter) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		logrus.Errorf("Error getting all events: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, events)
+	page.Next = nextPageLink(c, page.Limit, page.Offset, page.Total)
+	c.JSON(http.StatusOK, page)
 }
 
 // @Summary Cancel Event
@@ -329,6 +565,16 @@ func (h *Handler) cancelEvent(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
 			return
 		}
+		if errors.Is(err, domainErrors.ErrEventAlreadyFinished) ||
+			errors.Is(err, domainErrors.ErrEventAlreadyCancelled) ||
+			errors.Is(err, domainErrors.ErrInvalidEventTransition) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error cancelling event: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -336,3 +582,288 @@ func (h *Handler) cancelEvent(c *gin.Context) {
 
 	c.JSON(http.StatusOK, helpers.NewResponse("event cancelled successfully"))
 }
+
+// @Summary Publish Event
+// @Tags events
+// @Description Put a draft event on sale, or schedule it when publish_at is in the future. With moderation on, organizers submit it for review instead
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param input body requests.PublishEventRequestBody false "Optional publish time"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Event
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/publish [put]
+func (h *Handler) publishEvent(c *gin.Context) {
+	var inp requests.PublishEventRequest
+	// The body is optional, no body publishes right away
+	if err := c.ShouldBindJSON(&inp.Body); err != nil && !errors.Is(err, io.EOF) {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = eventID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	event, err := h.services.Events.PublishEvent(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventDateInvalid) ||
+			errors.Is(err, domainErrors.ErrEventAlreadyFinished) ||
+			errors.Is(err, domainErrors.ErrEventAlreadyCancelled) ||
+			errors.Is(err, domainErrors.ErrInvalidEventTransition) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) ||
+			errors.Is(err, domainErrors.ErrOrganizerNotApproved) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error publishing event: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// @Summary Get Event Refunds
+// @Tags events
+// @Description Get the per-ticket refund results of an event
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param status query string false "Refund status filter (pending/completed/failed)"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Refund
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/refunds [get]
+func (h *Handler) getEventRefunds(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventRefundsRequest{
+		EventID:     eventID,
+		OrganizerID: organizerID,
+		Role:        role,
+		Status:      c.Query(values.StatusQueryParam),
+	}
+
+	refunds, err := h.services.Payments.GetEventRefunds(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting event refunds: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, refunds)
+}
+
+// @Summary Approve Event
+// @Tags events
+// @Description Approve an event waiting for review, it is published now or at the time its organizer asked for
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Event
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/admin/{id}/approve [put]
+func (h *Handler) approveEvent(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	adminID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.ApproveEventRequest{
+		ID:      eventID,
+		AdminID: adminID,
+		Role:    role,
+	}
+
+	event, err := h.services.EventReviews.ApproveEvent(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotPendingReview) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error approving event: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// @Summary Reject Event
+// @Tags events
+// @Description Reject an event waiting for review, it goes back to its organizer as a draft
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param input body requests.RejectEventRequestBody true "Rejection reason"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Event
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/admin/{id}/reject [put]
+func (h *Handler) rejectEvent(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.RejectEventRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	adminID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = eventID
+	inp.AdminID = adminID
+	inp.Role = role
+
+	event, err := h.services.EventReviews.RejectEvent(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotPendingReview) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error rejecting event: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// @Summary Get Event Reviews
+// @Tags events
+// @Description Get the moderation history of an event, oldest first
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.EventReview
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/reviews [get]
+func (h *Handler) getEventReviews(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventReviewsRequest{
+		ID:          eventID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	reviews, err := h.services.EventReviews.GetEventReviews(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting event reviews: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, reviews)
+}
@@ -25,5 +25,15 @@ func (h *Handler) Init(api *gin.RouterGroup) {
 		h.initUsersRoutes(v1)
 		h.initEventsRoutes(v1)
 		h.initTicketsRoutes(v1)
+		h.initPaymentsRoutes(v1)
+		h.initSeatMapsRoutes(v1)
+		h.initVenuesRoutes(v1)
+		h.initCategoriesRoutes(v1)
+		h.initEventSeriesRoutes(v1)
+		h.initOrganizersRoutes(v1)
+		h.initOrganizationsRoutes(v1)
+		h.initRolesRoutes(v1)
+		h.initSessionsRoutes(v1)
+		h.initProfileRoutes(v1)
 	}
 }
@@ -3,6 +3,7 @@ package handlers
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"ticket-booking-app-backend/internal/helpers"
 	"ticket-booking-app-backend/internal/presentation/types"
@@ -86,3 +87,16 @@ func (h *Handler) validateContextIDKey(c *gin.Context, key string) (string, erro
 	}
 	return valueString, nil
 }
+
+// nextPageLink returns the request URL moved one page forward, or an empty string on the last page.
+func nextPageLink(c *gin.Context, limit, offset int, total int64) string {
+	if int64(offset+limit) >= total {
+		return ""
+	}
+
+	query := c.Request.URL.Query()
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset+limit))
+
+	return c.Request.URL.Path + "?" + query.Encode()
+}
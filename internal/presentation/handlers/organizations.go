@@ -0,0 +1,481 @@
+// internal/application/handlers/organizations.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initOrganizationsRoutes initializes the routes of organizer teams, their members and invitations
+func (h *Handler) initOrganizationsRoutes(api *gin.RouterGroup) {
+	organizations := api.Group("/organizations", h.authMiddleware.UserIdentity, h.authMiddleware.PermissionMiddleware(values.ResourceOrganizations, values.ActionRead, values.ScopeOwn))
+	{
+		organizations.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizations, values.ActionCreate, values.ScopeOwn), h.createOrganization)
+		organizations.GET("", h.getOrganizations)
+		organizations.GET("/:id", h.getOrganizationByID)
+		organizations.POST("/invitations/accept", h.acceptOrganizationInvitation)
+
+		// Member management, for owners and admins
+		organizations.POST("/:id/invitations", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizations, values.ActionUpdate, values.ScopeOwn), h.inviteOrganizationMember)
+		organizations.GET("/:id/invitations", h.getOrganizationInvitations)
+		organizations.PUT("/:id/members/:memberId", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizations, values.ActionUpdate, values.ScopeOwn), h.updateOrganizationMember)
+		organizations.DELETE("/:id/members/:memberId", h.removeOrganizationMember) // Members can also remove themselves
+	}
+}
+
+// @Summary Create Organization
+// @Tags organizations
+// @Description Create an organization, its creator becomes the owner
+// @Accept json
+// @Produce json
+// @Param input body requests.CreateOrganizationRequestBody true "Organization data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Organization
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations [post]
+func (h *Handler) createOrganization(c *gin.Context) {
+	var inp requests.CreateOrganizationRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.UserID = userID
+	inp.Role = role
+
+	organization, err := h.services.Organizations.CreateOrganization(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating organization: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, organization)
+}
+
+// @Summary List Organizations
+// @Tags organizations
+// @Description Get the organizations the current user is a member of, with their role
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Organization
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations [get]
+func (h *Handler) getOrganizations(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetOrganizationsRequest{
+		UserID: userID,
+		Role:   role,
+	}
+
+	organizations, err := h.services.Organizations.GetOrganizations(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting organizations: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organizations)
+}
+
+// @Summary Get Organization
+// @Tags organizations
+// @Description Get an organization with its members
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Organization
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/{id} [get]
+func (h *Handler) getOrganizationByID(c *gin.Context) {
+	organizationID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetOrganizationRequest{
+		ID:     organizationID,
+		UserID: userID,
+		Role:   role,
+	}
+
+	organization, err := h.services.Organizations.GetOrganizationByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization not found")
+			return
+		}
+		logrus.Errorf("Error getting organization: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organization)
+}
+
+// @Summary Invite Organization Member
+// @Tags organizations
+// @Description Invite someone to the organization by email. The invitation token is mailed to them
+// @Description and accepted by the invited user
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param input body requests.InviteMemberRequestBody true "Invitation data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.OrganizationInvitation
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/{id}/invitations [post]
+func (h *Handler) inviteOrganizationMember(c *gin.Context) {
+	organizationID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.InviteMemberRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = organizationID
+	inp.UserID = userID
+	inp.Role = role
+
+	invitation, err := h.services.Organizations.InviteMember(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidOrganizationRole) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationMemberExists) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error inviting organization member: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// @Summary List Organization Invitations
+// @Tags organizations
+// @Description Get the invitations of the organization that weren't accepted and didn't expire
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.OrganizationInvitation
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/{id}/invitations [get]
+func (h *Handler) getOrganizationInvitations(c *gin.Context) {
+	organizationID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetInvitationsRequest{
+		ID:     organizationID,
+		UserID: userID,
+		Role:   role,
+	}
+
+	invitations, err := h.services.Organizations.GetInvitations(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting organization invitations: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// @Summary Accept Organization Invitation
+// @Tags organizations
+// @Description Join an organization with an invitation token sent to the current user's email
+// @Accept json
+// @Produce json
+// @Param input body requests.AcceptInvitationRequestBody true "Invitation token"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Organization
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/invitations/accept [post]
+func (h *Handler) acceptOrganizationInvitation(c *gin.Context) {
+	var inp requests.AcceptInvitationRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+
+	inp.UserID = userID
+
+	organization, err := h.services.Organizations.AcceptInvitation(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvitationEmailMismatch) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvitationNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "invitation not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvitationExpired) ||
+			errors.Is(err, domainErrors.ErrOrganizationMemberExists) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error accepting organization invitation: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organization)
+}
+
+// @Summary Update Organization Member
+// @Tags organizations
+// @Description Change the role of a member, the owner keeps their role
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param memberId path string true "Member user ID"
+// @Param input body requests.UpdateMemberRoleRequestBody true "Member role"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.OrganizationMember
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/{id}/members/{memberId} [put]
+func (h *Handler) updateOrganizationMember(c *gin.Context) {
+	organizationID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	memberID, err := h.validateRequestIDParam(c, values.MemberIdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.UpdateMemberRoleRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = organizationID
+	inp.MemberID = memberID
+	inp.UserID = userID
+	inp.Role = role
+
+	member, err := h.services.Organizations.UpdateMemberRole(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidOrganizationRole) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationMemberNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization member not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationOwnerRequired) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error updating organization member: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+// @Summary Remove Organization Member
+// @Tags organizations
+// @Description Remove a member from the organization, members can remove themselves to leave it.
+// @Description The owner can't be removed
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param memberId path string true "Member user ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/organizations/{id}/members/{memberId} [delete]
+func (h *Handler) removeOrganizationMember(c *gin.Context) {
+	organizationID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	memberID, err := h.validateRequestIDParam(c, values.MemberIdQueryParam)
+	if err != nil {
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.RemoveMemberRequest{
+		ID:       organizationID,
+		MemberID: memberID,
+		UserID:   userID,
+		Role:     role,
+	}
+
+	if err := h.services.Organizations.RemoveMember(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationMemberNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organization member not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizationOwnerRequired) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error removing organization member: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("member removed successfully"))
+}
@@ -0,0 +1,236 @@
+// internal/application/handlers/organizers.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initOrganizersRoutes initializes the routes admins use to review organizer accounts
+func (h *Handler) initOrganizersRoutes(api *gin.RouterGroup) {
+	organizers := api.Group("/admin/organizers", h.authMiddleware.UserIdentity)
+	{
+		organizers.GET("", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizers, values.ActionRead, values.ScopeAny), h.getOrganizers)
+		organizers.PUT("/:id/approve", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizers, values.ActionReview, values.ScopeAny), h.approveOrganizer)
+		organizers.PUT("/:id/reject", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizers, values.ActionReview, values.ScopeAny), h.rejectOrganizer)
+		organizers.PUT("/:id/suspend", h.authMiddleware.PermissionMiddleware(values.ResourceOrganizers, values.ActionReview, values.ScopeAny), h.suspendOrganizer)
+	}
+}
+
+// @Summary List Organizers
+// @Tags organizers
+// @Description Get organizer accounts, optionally filtered by status (pending, active, rejected, suspended)
+// @Accept json
+// @Produce json
+// @Param status query string false "Organizer status"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.User
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/organizers [get]
+func (h *Handler) getOrganizers(c *gin.Context) {
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetOrganizersRequest{
+		Status: c.Query(values.StatusQueryParam),
+		Role:   role,
+	}
+
+	organizers, err := h.services.Organizers.GetOrganizers(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting organizers: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organizers)
+}
+
+// @Summary Approve Organizer
+// @Tags organizers
+// @Description Approve a pending or rejected organizer, or reinstate a suspended one
+// @Accept json
+// @Produce json
+// @Param id path string true "Organizer ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/organizers/{id}/approve [put]
+func (h *Handler) approveOrganizer(c *gin.Context) {
+	organizerID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.ApproveOrganizerRequest{
+		ID:   organizerID,
+		Role: role,
+	}
+
+	organizer, err := h.services.Organizers.ApproveOrganizer(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizerNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organizer not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvalidOrganizerTransition) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error approving organizer: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organizer)
+}
+
+// @Summary Reject Organizer
+// @Tags organizers
+// @Description Reject a pending organizer with a reason shown to them
+// @Accept json
+// @Produce json
+// @Param id path string true "Organizer ID"
+// @Param input body requests.OrganizerStatusRequestBody true "Rejection reason"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/organizers/{id}/reject [put]
+func (h *Handler) rejectOrganizer(c *gin.Context) {
+	organizerID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.RejectOrganizerRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = organizerID
+	inp.Role = role
+
+	organizer, err := h.services.Organizers.RejectOrganizer(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizerNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organizer not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvalidOrganizerTransition) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error rejecting organizer: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organizer)
+}
+
+// @Summary Suspend Organizer
+// @Tags organizers
+// @Description Suspend an approved organizer, sales of all their events stop and unpaid reservations are cancelled. Events with paid tickets stay visible, closed for sales, the others and the series go back to draft
+// @Accept json
+// @Produce json
+// @Param id path string true "Organizer ID"
+// @Param input body requests.OrganizerStatusRequestBody true "Suspension reason"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/organizers/{id}/suspend [put]
+func (h *Handler) suspendOrganizer(c *gin.Context) {
+	organizerID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.SuspendOrganizerRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = organizerID
+	inp.Role = role
+
+	organizer, err := h.services.Organizers.SuspendOrganizer(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrOrganizerNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "organizer not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvalidOrganizerTransition) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		logrus.Errorf("Error suspending organizer: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, organizer)
+}
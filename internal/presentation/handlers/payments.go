@@ -0,0 +1,304 @@
+// internal/application/handlers/payments.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/internal/infrastructure/payments"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initPaymentsRoutes initializes the payment routes
+func (h *Handler) initPaymentsRoutes(api *gin.RouterGroup) {
+	payments := api.Group("/payments")
+	{
+		// Provider callbacks, authenticated by their signature
+		payments.POST("/webhook", h.paymentWebhook)
+
+		// User routes
+		user := payments.Group("", h.authMiddleware.UserIdentity)
+		{
+			user.POST("/checkout", h.checkout)
+			user.GET("/my", h.getUserPayments)
+			user.GET("/my/:id", h.getPaymentByID)
+			user.PUT("/my/:id/confirm", h.confirmPayment)
+			user.GET("/refunds/my", h.getUserRefunds)
+		}
+	}
+}
+
+// @Summary Checkout Tickets
+// @Tags payments
+// @Description Create a pending payment for one or more reserved tickets
+// @Accept json
+// @Produce json
+// @Param input body requests.CheckoutRequestBody true "Tickets to pay for"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Payment
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/checkout [post]
+func (h *Handler) checkout(c *gin.Context) {
+	var inp requests.CheckoutRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.UserID = userID
+	inp.Role = role
+
+	payment, err := h.services.Payments.Checkout(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrTicketNotPayable) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating checkout: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, payment)
+}
+
+// @Summary Get User Payments
+// @Tags payments
+// @Description Get all payments of the authenticated user
+// @Accept json
+// @Produce json
+// @Param status query string false "Payment status filter (pending/completed/failed/refunded)"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Payment
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/my [get]
+func (h *Handler) getUserPayments(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.GetUserPaymentsRequest{
+		UserID: userID,
+		Role:   role,
+		Status: c.Query(values.StatusQueryParam),
+	}
+
+	payments, err := h.services.Payments.GetUserPayments(c.Request.Context(), &inp)
+	if err != nil {
+		logrus.Errorf("Error getting user payments: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, payments)
+}
+
+// @Summary Get Payment Details
+// @Tags payments
+// @Description Get details of a specific payment
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Payment
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/my/{id} [get]
+func (h *Handler) getPaymentByID(c *gin.Context) {
+	paymentID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.GetPaymentByIDRequest{
+		PaymentID: paymentID,
+		UserID:    userID,
+		Role:      role,
+	}
+
+	payment, err := h.services.Payments.GetPaymentByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrPaymentNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "payment not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting payment: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, payment)
+}
+
+// @Summary Confirm Payment
+// @Tags payments
+// @Description Sync a pending payment with the payment provider and mark its tickets as paid once it succeeds
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Payment
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/my/{id}/confirm [put]
+func (h *Handler) confirmPayment(c *gin.Context) {
+	paymentID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.ConfirmPaymentRequest{
+		PaymentID: paymentID,
+		UserID:    userID,
+		Role:      role,
+	}
+
+	payment, err := h.services.Payments.ConfirmPayment(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrPaymentNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "payment not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error confirming payment: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, payment)
+}
+
+// @Summary Get User Refunds
+// @Tags payments
+// @Description Get all refunds of the authenticated user
+// @Accept json
+// @Produce json
+// @Param status query string false "Refund status filter (pending/completed/failed)"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Refund
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/refunds/my [get]
+func (h *Handler) getUserRefunds(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.GetUserRefundsRequest{
+		UserID: userID,
+		Role:   role,
+		Status: c.Query(values.StatusQueryParam),
+	}
+
+	refunds, err := h.services.Payments.GetUserRefunds(c.Request.Context(), &inp)
+	if err != nil {
+		logrus.Errorf("Error getting user refunds: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, refunds)
+}
+
+// @Summary Payment Webhook
+// @Tags payments
+// @Description Receive a signed payment status callback from the payment provider
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Hex encoded HMAC-SHA256 of the request body"
+// @Param input body payments.WebhookEvent true "Webhook event"
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/payments/webhook [post]
+func (h *Handler) paymentWebhook(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	inp := requests.PaymentWebhookRequest{
+		Payload:   payload,
+		Signature: c.GetHeader(payments.SignatureHeader),
+	}
+
+	if err := h.services.Payments.HandleWebhook(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidWebhook) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrPaymentNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "payment not found")
+			return
+		}
+		logrus.Errorf("Error handling payment webhook: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("webhook processed"))
+}
@@ -0,0 +1,144 @@
+// internal/application/handlers/profile.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initProfileRoutes initializes the routes users manage their own account with
+func (h *Handler) initProfileRoutes(api *gin.RouterGroup) {
+	me := api.Group("/users/me", h.authMiddleware.UserIdentity)
+	{
+		me.GET("", h.getProfile)
+		me.PATCH("", h.updateProfile)
+		me.POST("/password", h.changePassword)
+	}
+}
+
+// @Summary Get Profile
+// @Tags profile
+// @Description Get the account of the authenticated user
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 401 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/me [get]
+func (h *Handler) getProfile(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.GetProfileRequest{
+		UserID: userID,
+	}
+
+	user, err := h.services.Users.GetProfile(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "user not found")
+			return
+		}
+		logrus.Errorf("Error getting profile: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// @Summary Update Profile
+// @Tags profile
+// @Description Change the name, address or phone of the authenticated user, fields left out stay as they are
+// @Accept json
+// @Produce json
+// @Param input body requests.UpdateProfileRequestBody true "Profile data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/me [patch]
+func (h *Handler) updateProfile(c *gin.Context) {
+	var inp requests.UpdateProfileRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	inp.UserID = userID
+
+	user, err := h.services.Users.UpdateProfile(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "user not found")
+			return
+		}
+		logrus.Errorf("Error updating profile: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// @Summary Change Password
+// @Tags profile
+// @Description Replace the password of the authenticated user, every other session is signed out
+// @Accept json
+// @Produce json
+// @Param input body requests.ChangePasswordRequestBody true "Current and new password"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/me/password [post]
+func (h *Handler) changePassword(c *gin.Context) {
+	var inp requests.ChangePasswordRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	inp.UserID = userID
+	inp.SessionID = c.GetString(values.SessionIdCtx)
+
+	if err := h.services.Users.ChangePassword(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrUserPasswordIncorrect) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "user not found")
+			return
+		}
+		logrus.Errorf("Error changing password: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("password changed successfully"))
+}
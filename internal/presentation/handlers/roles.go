@@ -0,0 +1,297 @@
+// internal/application/handlers/roles.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initRolesRoutes initializes the routes admins manage roles and the users holding them with
+func (h *Handler) initRolesRoutes(api *gin.RouterGroup) {
+	roles := api.Group("/admin/roles", h.authMiddleware.UserIdentity)
+	{
+		roles.GET("", h.authMiddleware.PermissionMiddleware(values.ResourceRoles, values.ActionRead, values.ScopeAny), h.getRoles)
+		roles.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceRoles, values.ActionCreate, values.ScopeAny), h.createRole)
+		roles.PUT("/:name", h.authMiddleware.PermissionMiddleware(values.ResourceRoles, values.ActionUpdate, values.ScopeAny), h.updateRole)
+		roles.DELETE("/:name", h.authMiddleware.PermissionMiddleware(values.ResourceRoles, values.ActionDelete, values.ScopeAny), h.deleteRole)
+	}
+
+	users := api.Group("/admin/users", h.authMiddleware.UserIdentity)
+	{
+		users.PUT("/:id/role", h.authMiddleware.PermissionMiddleware(values.ResourceUsers, values.ActionUpdate, values.ScopeAny), h.assignUserRole)
+	}
+}
+
+// @Summary List Roles
+// @Tags roles
+// @Description Get the built-in roles followed by the custom ones (admin only)
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Role
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/roles [get]
+func (h *Handler) getRoles(c *gin.Context) {
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetRolesRequest{
+		Role: role,
+	}
+
+	roles, err := h.services.Roles.GetRoles(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting roles: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// @Summary Create Role
+// @Tags roles
+// @Description Define a custom role from permissions formatted as resource:action:scope (admin only)
+// @Accept json
+// @Produce json
+// @Param input body requests.CreateRoleRequestBody true "Role data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Role
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/roles [post]
+func (h *Handler) createRole(c *gin.Context) {
+	var inp requests.CreateRoleRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+	inp.Role = role
+
+	created, err := h.services.Roles.CreateRole(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidRoleName) ||
+			errors.Is(err, domainErrors.ErrInvalidPermission) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrRoleExists) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating role: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Update Role
+// @Tags roles
+// @Description Replace the description and permissions of a custom role (admin only)
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Param input body requests.UpdateRoleRequestBody true "Role data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Role
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/roles/{name} [put]
+func (h *Handler) updateRole(c *gin.Context) {
+	name, err := h.validateRequestParam(c, values.NameQueryParam)
+	if err != nil {
+		return
+	}
+
+	var inp requests.UpdateRoleRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+	inp.Name = name
+	inp.Role = role
+
+	updated, err := h.services.Roles.UpdateRole(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidPermission) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrRoleNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "role not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrBuiltInRole) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error updating role: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// @Summary Delete Role
+// @Tags roles
+// @Description Remove a custom role no user holds anymore (admin only)
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/roles/{name} [delete]
+func (h *Handler) deleteRole(c *gin.Context) {
+	name, err := h.validateRequestParam(c, values.NameQueryParam)
+	if err != nil {
+		return
+	}
+
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.DeleteRoleRequest{
+		Name: name,
+		Role: role,
+	}
+
+	if err := h.services.Roles.DeleteRole(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrRoleNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "role not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrBuiltInRole) ||
+			errors.Is(err, domainErrors.ErrRoleInUse) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error deleting role: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("role deleted successfully"))
+}
+
+// @Summary Assign User Role
+// @Tags roles
+// @Description Give a user a built-in or custom role, the caller must hold every permission of the new and the current role. The user is signed out everywhere and gets the role at their next sign-in (admin only)
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param input body requests.AssignUserRoleRequestBody true "Role"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.User
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/users/{id}/role [put]
+func (h *Handler) assignUserRole(c *gin.Context) {
+	id, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	var inp requests.AssignUserRoleRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	inp.ID = id
+	inp.UserID = userID
+	inp.Role = role
+
+	user, err := h.services.Roles.AssignUserRole(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrRoleNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "role not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "user not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error assigning user role: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
@@ -0,0 +1,283 @@
+// internal/application/handlers/seat_maps.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initSeatMapsRoutes initializes the seat map routes
+func (h *Handler) initSeatMapsRoutes(api *gin.RouterGroup) {
+	seatMaps := api.Group("/seat-maps", h.authMiddleware.UserIdentity)
+	{
+		seatMaps.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceSeatMaps, values.ActionCreate, values.ScopeOwn), h.createSeatMap)
+		seatMaps.GET("", h.authMiddleware.PermissionMiddleware(values.ResourceSeatMaps, values.ActionRead, values.ScopeOwn), h.getOrganizerSeatMaps)
+		seatMaps.GET("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceSeatMaps, values.ActionRead, values.ScopeOwn), h.getSeatMapByID)
+	}
+}
+
+// @Summary Create Seat Map
+// @Tags seat-maps
+// @Description Create a seat map made of sections and rows, seats of a row are numbered from 1
+// @Accept json
+// @Produce json
+// @Param input body requests.CreateSeatMapRequestBody true "Seat map layout"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.SeatMap
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/seat-maps [post]
+func (h *Handler) createSeatMap(c *gin.Context) {
+	var inp requests.CreateSeatMapRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	seatMap, err := h.services.SeatMaps.CreateSeatMap(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating seat map: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, seatMap)
+}
+
+// @Summary List Seat Maps
+// @Tags seat-maps
+// @Description Get the seat maps of the authenticated organizer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.SeatMap
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/seat-maps [get]
+func (h *Handler) getOrganizerSeatMaps(c *gin.Context) {
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetOrganizerSeatMapsRequest{
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	seatMaps, err := h.services.SeatMaps.GetOrganizerSeatMaps(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting seat maps: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, seatMaps)
+}
+
+// @Summary Get Seat Map
+// @Tags seat-maps
+// @Description Get a seat map with its sections, rows and seats
+// @Accept json
+// @Produce json
+// @Param id path string true "Seat map ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.SeatMap
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/seat-maps/{id} [get]
+func (h *Handler) getSeatMapByID(c *gin.Context) {
+	seatMapID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetSeatMapByIDRequest{
+		ID:          seatMapID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	seatMap, err := h.services.SeatMaps.GetSeatMapByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrSeatMapNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "seat map not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting seat map: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, seatMap)
+}
+
+// @Summary Attach Seat Map
+// @Tags seat-maps
+// @Description Switch an event to reserved seating. The event capacity becomes the number of seats, which must fit into its venue, only possible before tickets are sold
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param input body requests.AttachSeatMapRequestBody true "Seat map to use"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/seat-map [put]
+func (h *Handler) attachSeatMap(c *gin.Context) {
+	var inp requests.AttachSeatMapRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.EventID = eventID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	if err := h.services.SeatMaps.AttachSeatMap(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrSeatMapLocked) ||
+			errors.Is(err, domainErrors.ErrSeatMapVenueMismatch) ||
+			errors.Is(err, domainErrors.ErrTicketTypeCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrVenueCapacityExceeded) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotFound) || errors.Is(err, domainErrors.ErrSeatMapNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error attaching seat map: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("seat map attached successfully"))
+}
+
+// @Summary Get Event Seats
+// @Tags seat-maps
+// @Description Get the seat map of an event with the availability of every seat. Unpublished events follow the same visibility rules as Get Event
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.SeatMap
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/{id}/seats [get]
+func (h *Handler) getEventSeats(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventSeatsRequest{
+		EventID: eventID,
+		UserID:  c.GetString(values.UserIdCtx),
+		Role:    role,
+	}
+
+	seatMap, err := h.services.SeatMaps.GetEventSeats(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrEventNotFound) || errors.Is(err, domainErrors.ErrSeatMapNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error getting event seats: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, seatMap)
+}
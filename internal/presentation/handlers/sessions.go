@@ -0,0 +1,136 @@
+// internal/application/handlers/sessions.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initSessionsRoutes initializes the routes that sign users out before their tokens expire
+func (h *Handler) initSessionsRoutes(api *gin.RouterGroup) {
+	auth := api.Group("/auth", h.authMiddleware.UserIdentity)
+	{
+		auth.POST("/logout", h.logout)        // Sign out of this session
+		auth.POST("/logout-all", h.logoutAll) // Sign out of every session
+	}
+
+	admin := api.Group("/admin/users", h.authMiddleware.UserIdentity)
+	{
+		admin.POST("/:id/logout", h.authMiddleware.PermissionMiddleware(values.ResourceUsers, values.ActionUpdate, values.ScopeAny), h.forceLogout)
+	}
+}
+
+// @Summary Logout
+// @Tags auth
+// @Description Sign out of the current session, its access and refresh tokens stop working
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/auth/logout [post]
+func (h *Handler) logout(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.LogoutRequest{
+		UserID:    userID,
+		SessionID: c.GetString(values.SessionIdCtx),
+	}
+
+	if err := h.services.Sessions.Logout(c.Request.Context(), &inp); err != nil {
+		logrus.Errorf("Error signing out: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("signed out successfully"))
+}
+
+// @Summary Logout All Sessions
+// @Tags auth
+// @Description Sign out of every session of the authenticated user
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/auth/logout-all [post]
+func (h *Handler) logoutAll(c *gin.Context) {
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.LogoutAllRequest{
+		UserID: userID,
+	}
+
+	if err := h.services.Sessions.LogoutAll(c.Request.Context(), &inp); err != nil {
+		logrus.Errorf("Error signing out of all sessions: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("signed out of all sessions successfully"))
+}
+
+// @Summary Force Logout
+// @Tags auth
+// @Description Sign a user out of every session (admin only)
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/admin/users/{id}/logout [post]
+func (h *Handler) forceLogout(c *gin.Context) {
+	id, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.ForceLogoutRequest{
+		ID:   id,
+		Role: role,
+	}
+
+	if err := h.services.Sessions.ForceLogout(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "user not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error forcing logout: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("user signed out successfully"))
+}
@@ -0,0 +1,252 @@
+// internal/application/handlers/ticket_types.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// @Summary List Event Ticket Types
+// @Tags ticket-types
+// @Description Get the ticket types sold for an event
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.TicketType
+// @Failure 401 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/{id}/ticket-types [get]
+func (h *Handler) getEventTicketTypes(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.GetEventTicketTypesRequest{
+		EventID: eventID,
+		Role:    role,
+	}
+
+	ticketTypes, err := h.services.TicketTypes.GetEventTicketTypes(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		logrus.Errorf("Error getting ticket types: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ticketTypes)
+}
+
+// @Summary Create Ticket Type
+// @Tags ticket-types
+// @Description Add a ticket type to an event. Capacities of all ticket types can't exceed the event capacity
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param input body requests.TicketTypeRequestBody true "Ticket type data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.TicketType
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/ticket-types [post]
+func (h *Handler) createTicketType(c *gin.Context) {
+	var inp requests.CreateTicketTypeRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.EventID = eventID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	ticketType, err := h.services.TicketTypes.CreateTicketType(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrTicketTypeCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrTicketTypeSalesWindow) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating ticket type: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, ticketType)
+}
+
+// @Summary Update Ticket Type
+// @Tags ticket-types
+// @Description Update a ticket type of an event. Capacity can't go below the tickets already sold
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param typeId path string true "Ticket type ID"
+// @Param input body requests.TicketTypeRequestBody true "Ticket type data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.TicketType
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/ticket-types/{typeId} [put]
+func (h *Handler) updateTicketType(c *gin.Context) {
+	var inp requests.UpdateTicketTypeRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	ticketTypeID, err := h.validateRequestIDParam(c, values.TypeIdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = ticketTypeID
+	inp.EventID = eventID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	ticketType, err := h.services.TicketTypes.UpdateTicketType(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrTicketTypeCapacityExceeded) ||
+			errors.Is(err, domainErrors.ErrTicketTypeSalesWindow) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrTicketTypeNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket type not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error updating ticket type: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ticketType)
+}
+
+// @Summary Delete Ticket Type
+// @Tags ticket-types
+// @Description Delete a ticket type that has no tickets yet
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param typeId path string true "Ticket type ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/events/organizer/{id}/ticket-types/{typeId} [delete]
+func (h *Handler) deleteTicketType(c *gin.Context) {
+	eventID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	ticketTypeID, err := h.validateRequestIDParam(c, values.TypeIdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.DeleteTicketTypeRequest{
+		ID:          ticketTypeID,
+		EventID:     eventID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	if err := h.services.TicketTypes.DeleteTicketType(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrTicketTypeInUse) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrTicketTypeNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket type not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error deleting ticket type: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("ticket type deleted successfully"))
+}
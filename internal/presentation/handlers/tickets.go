@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	types "ticket-booking-app-backend/internal/application/types/errors"
 	"ticket-booking-app-backend/internal/application/types/requests"
 	domainErrors "ticket-booking-app-backend/internal/domain/types"
 	"ticket-booking-app-backend/internal/helpers"
@@ -23,15 +24,16 @@ func (h *Handler) initTicketsRoutes(api *gin.RouterGroup) {
 		tickets.GET("/my", h.getUserTickets)
 		tickets.GET("/my/:id", h.getTicketByID)
 		tickets.PUT("/my/:id/cancel", h.cancelTicket)
+		tickets.POST("/my/:id/refund", h.requestRefund)
 
 		// Organizer routes
-		organizer := tickets.Group("/organizer", h.authMiddleware.RoleMiddleware(values.OrganizerRole))
+		organizer := tickets.Group("/organizer", h.authMiddleware.PermissionMiddleware(values.ResourceTickets, values.ActionRead, values.ScopeOwn))
 		{
 			organizer.GET("", h.getEventTickets)
 		}
 
 		// Admin routes
-		admin := tickets.Group("/admin", h.authMiddleware.RoleMiddleware(values.AdminRole))
+		admin := tickets.Group("/admin", h.authMiddleware.PermissionMiddleware(values.ResourceTickets, values.ActionRead, values.ScopeAny))
 		{
 			admin.GET("", h.getEventTickets)
 		}
@@ -43,13 +45,14 @@ func (h *Handler) initTicketsRoutes(api *gin.RouterGroup) {
 // @Description Reserve tickets for an event
 // @Accept json
 // @Produce json
-// @Param input body requests.ReserveTicketsRequest true "Reservation details"
+// @Param input body requests.ReserveTicketsRequestBody true "Reservation details, either a quantity at the base price or ticket type items"
 // @Security ApiKeyAuth
 // @Success 201 {array} entities.Ticket
 // @Failure 400 {object} helpers.Response
 // @Failure 401 {object} helpers.Response
 // @Failure 403 {object} helpers.Response
 // @Failure 404 {object} helpers.Response
+// @Failure 409 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
 // @Router /api/v1/tickets/reserve [post]
 func (h *Handler) reserveTickets(c *gin.Context) {
@@ -79,10 +82,32 @@ func (h *Handler) reserveTickets(c *gin.Context) {
 
 	tickets, err := h.services.Tickets.ReserveTickets(c.Request.Context(), &inp)
 	if err != nil {
-		if errors.Is(err, domainErrors.ErrInsufficientTickets) {
+		if errors.Is(err, domainErrors.ErrInsufficientTickets) ||
+			errors.Is(err, domainErrors.ErrTicketLimitExceeded) ||
+			errors.Is(err, domainErrors.ErrEventNotActive) ||
+			errors.Is(err, domainErrors.ErrEventSalesClosed) ||
+			errors.Is(err, domainErrors.ErrTicketTypeNotOnSale) ||
+			errors.Is(err, domainErrors.ErrTicketTypeRequired) ||
+			errors.Is(err, domainErrors.ErrInvalidSeatSelection) {
 			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
 			return
 		}
+		if errors.Is(err, domainErrors.ErrEventNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrTicketTypeNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket type not found")
+			return
+		}
+		if errors.Is(err, domainErrors.ErrSeatNotAvailable) {
+			helpers.NewErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrEmailNotVerified) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error reserving tickets: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -171,6 +196,10 @@ func (h *Handler) getTicketByID(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket not found")
 			return
 		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error getting ticket: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -220,6 +249,10 @@ func (h *Handler) getEventTickets(c *gin.Context) {
 
 	tickets, err := h.services.Tickets.GetEventTickets(c.Request.Context(), &inp)
 	if err != nil {
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error getting event tickets: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -271,6 +304,10 @@ func (h *Handler) cancelTicket(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket not found")
 			return
 		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
 		logrus.Errorf("Error cancelling ticket: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
@@ -278,3 +315,61 @@ func (h *Handler) cancelTicket(c *gin.Context) {
 
 	c.JSON(http.StatusOK, helpers.NewResponse("ticket cancelled successfully"))
 }
+
+// @Summary Request Refund
+// @Tags tickets
+// @Description Refund a paid ticket according to the event's refund policy
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Refund
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/tickets/my/{id}/refund [post]
+func (h *Handler) requestRefund(c *gin.Context) {
+	ticketID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	userID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		return
+	}
+
+	inp := requests.RequestRefundRequest{
+		TicketID: ticketID,
+		UserID:   userID,
+		Role:     role,
+	}
+
+	refund, err := h.services.Payments.RequestRefund(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidTicketStatus) ||
+			errors.Is(err, domainErrors.ErrRefundNotAllowed) ||
+			errors.Is(err, domainErrors.ErrRefundAlreadyRequested) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrTicketNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "ticket not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error requesting refund: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, refund)
+}
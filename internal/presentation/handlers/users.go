@@ -9,6 +9,7 @@ import (
 	"ticket-booking-app-backend/internal/helpers"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
 )
 
 // initUsersRoutes initializes the user routes.
@@ -17,6 +18,10 @@ func (h *Handler) initUsersRoutes(api *gin.RouterGroup) {
 	{
 		users.POST("/sign-in", h.userSignIn)
 		users.POST("/sign-up", h.userSignUp)
+		users.POST("/verify", h.verifyEmail)
+		users.POST("/verify/resend", h.resendVerification)
+		users.POST("/forgot-password", h.forgotPassword)
+		users.POST("/reset-password", h.resetPassword)
 	}
 	admin := api.Group("/admin")
 	{
@@ -27,12 +32,16 @@ func (h *Handler) initUsersRoutes(api *gin.RouterGroup) {
 		organizer.POST("/sign-in", h.organizerSignIn)
 		organizer.POST("/sign-up", h.organizerSignUp)
 	}
+	auth := api.Group("/auth")
+	{
+		auth.POST("/refresh", h.refreshToken)
+	}
 }
 
 // userSignUp handles the user sign up request.
 // @Summary User SignUp
 // @Tags users-auth
-// @Description Register a new user
+// @Description Register a new user, a code to verify their email is mailed to them
 // @Accept json
 // @Produce json
 // @Param input body requests.UserSignUpRequest true "User sign up info"
@@ -121,7 +130,7 @@ func (h *Handler) adminSignIn(c *gin.Context) {
 // organizerSignUp handles the organizer sign up request.
 // @Summary Organizer SignUp
 // @Tags organizer-auth
-// @Description Register a new organizer
+// @Description Register a new organizer, a code to verify their email is mailed to them
 // @Accept json
 // @Produce json
 // @Param input body requests.OrganizerSignUpRequest true "Organizer sign up info"
@@ -154,6 +163,7 @@ func (h *Handler) organizerSignUp(c *gin.Context) {
 // @Param input body requests.OrganizerSignInRequest true "Organizer sign in info"
 // @Success 200 {object} responses.TokenResponse
 // @Failure 400 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
 // @Failure 500 {object} helpers.Response
 // @Router /api/v1/organizer/sign-in [post]
 func (h *Handler) organizerSignIn(c *gin.Context) {
@@ -169,9 +179,166 @@ func (h *Handler) organizerSignIn(c *gin.Context) {
 			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
 			return
 		}
+		if errors.Is(err, domainErrors.ErrOrganizerNotApproved) ||
+			errors.Is(err, domainErrors.ErrOrganizerRejected) ||
+			errors.Is(err, domainErrors.ErrOrganizerSuspended) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, &res)
+}
+
+// refreshToken handles the token refresh request.
+// @Summary Refresh Token
+// @Tags auth
+// @Description Swap a refresh token for a new access token and refresh token, each refresh token works once
+// @Accept json
+// @Produce json
+// @Param input body requests.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} responses.TokenResponse
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/auth/refresh [post]
+func (h *Handler) refreshToken(c *gin.Context) {
+	var inp requests.RefreshTokenRequest
+	if err := c.BindJSON(&inp); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	res, err := h.services.Users.RefreshToken(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrRefreshTokenInvalid) ||
+			errors.Is(err, domainErrors.ErrRefreshTokenReused) {
+			helpers.NewErrorResponse(c, http.StatusUnauthorized, err.Error())
+			return
+		}
+		logrus.Errorf("Error refreshing token: %s", err)
 		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	c.JSON(http.StatusOK, &res)
 }
+
+// verifyEmail handles the email verification request.
+// @Summary Verify Email
+// @Tags users-auth
+// @Description Confirm the email address of an account with the code mailed on sign-up
+// @Accept json
+// @Produce json
+// @Param input body requests.VerifyEmailRequest true "Email and verification code"
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/verify [post]
+func (h *Handler) verifyEmail(c *gin.Context) {
+	var inp requests.VerifyEmailRequest
+	if err := c.BindJSON(&inp); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if err := h.services.Users.VerifyEmail(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrVerificationCodeInvalid) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		logrus.Errorf("Error verifying email: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("email verified successfully"))
+}
+
+// resendVerification handles the request for a new verification code.
+// @Summary Resend Verification Code
+// @Tags users-auth
+// @Description Mail a new verification code, the previous one stops working
+// @Accept json
+// @Produce json
+// @Param input body requests.ResendVerificationRequest true "Email"
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/verify/resend [post]
+func (h *Handler) resendVerification(c *gin.Context) {
+	var inp requests.ResendVerificationRequest
+	if err := c.BindJSON(&inp); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if err := h.services.Users.ResendVerification(c.Request.Context(), &inp); err != nil {
+		logrus.Errorf("Error resending verification code: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Same answer whether or not the email belongs to an unverified account
+	c.JSON(http.StatusOK, helpers.NewResponse("verification code sent if the account needs one"))
+}
+
+// forgotPassword handles the password reset request.
+// @Summary Forgot Password
+// @Tags users-auth
+// @Description Mail a password reset token to the account of the email. The response is the same whether or not an account exists
+// @Accept json
+// @Produce json
+// @Param input body requests.ForgotPasswordRequest true "Email"
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/forgot-password [post]
+func (h *Handler) forgotPassword(c *gin.Context) {
+	var inp requests.ForgotPasswordRequest
+	if err := c.BindJSON(&inp); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if err := h.services.Users.ForgotPassword(c.Request.Context(), &inp); err != nil {
+		logrus.Errorf("Error requesting password reset: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, "password reset couldn't be requested")
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("password reset sent if an account exists for the email"))
+}
+
+// resetPassword handles the request to set a new password with a reset token.
+// @Summary Reset Password
+// @Tags users-auth
+// @Description Set a new password with a mailed reset token, every session of the account is signed out
+// @Accept json
+// @Produce json
+// @Param input body requests.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/users/reset-password [post]
+func (h *Handler) resetPassword(c *gin.Context) {
+	var inp requests.ResetPasswordRequest
+	if err := c.BindJSON(&inp); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body")
+		return
+	}
+
+	if err := h.services.Users.ResetPassword(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrPasswordResetTokenInvalid) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		logrus.Errorf("Error resetting password: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, "password couldn't be reset")
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("password reset successfully"))
+}
@@ -0,0 +1,265 @@
+// internal/application/handlers/venues.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	"ticket-booking-app-backend/internal/application/types/requests"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
+	"ticket-booking-app-backend/internal/helpers"
+	"ticket-booking-app-backend/pkg/values"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// initVenuesRoutes initializes the venue routes
+func (h *Handler) initVenuesRoutes(api *gin.RouterGroup) {
+	venues := api.Group("/venues", h.authMiddleware.UserIdentity)
+	{
+		// Public routes
+		venues.GET("", h.getVenues)
+		venues.GET("/:id", h.getVenueByID)
+
+		// Organizer and admin routes
+		venues.POST("", h.authMiddleware.PermissionMiddleware(values.ResourceVenues, values.ActionCreate, values.ScopeOwn), h.createVenue)
+		venues.PUT("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceVenues, values.ActionUpdate, values.ScopeOwn), h.updateVenue)
+		venues.DELETE("/:id", h.authMiddleware.PermissionMiddleware(values.ResourceVenues, values.ActionDelete, values.ScopeOwn), h.deleteVenue)
+	}
+}
+
+// @Summary List Venues
+// @Tags venues
+// @Description Get venues, optionally filtered by city and country
+// @Accept json
+// @Produce json
+// @Param city query string false "City"
+// @Param country query string false "Country"
+// @Security ApiKeyAuth
+// @Success 200 {array} entities.Venue
+// @Failure 401 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/venues [get]
+func (h *Handler) getVenues(c *gin.Context) {
+	inp := requests.GetVenuesRequest{
+		City:    c.Query(values.CityQueryParam),
+		Country: c.Query(values.CountryQueryParam),
+	}
+
+	venues, err := h.services.Venues.GetVenues(c.Request.Context(), &inp)
+	if err != nil {
+		logrus.Errorf("Error getting venues: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, venues)
+}
+
+// @Summary Get Venue
+// @Tags venues
+// @Description Get details of a venue
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Venue
+// @Failure 401 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/venues/{id} [get]
+func (h *Handler) getVenueByID(c *gin.Context) {
+	venueID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+
+	inp := requests.GetVenueByIDRequest{
+		ID: venueID,
+	}
+
+	venue, err := h.services.Venues.GetVenueByID(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		logrus.Errorf("Error getting venue: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, venue)
+}
+
+// @Summary Create Venue
+// @Tags venues
+// @Description Add a venue that events can take place at
+// @Accept json
+// @Produce json
+// @Param input body requests.VenueRequestBody true "Venue data"
+// @Security ApiKeyAuth
+// @Success 201 {object} entities.Venue
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/venues [post]
+func (h *Handler) createVenue(c *gin.Context) {
+	var inp requests.CreateVenueRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	venue, err := h.services.Venues.CreateVenue(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidTimezone) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error creating venue: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, venue)
+}
+
+// @Summary Update Venue
+// @Tags venues
+// @Description Update a venue. Its capacity can't go below the capacity of its upcoming events
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Param input body requests.VenueRequestBody true "Venue data"
+// @Security ApiKeyAuth
+// @Success 200 {object} entities.Venue
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/venues/{id} [put]
+func (h *Handler) updateVenue(c *gin.Context) {
+	var inp requests.UpdateVenueRequest
+	if err := c.BindJSON(&inp.Body); err != nil {
+		helpers.NewErrorResponse(c, http.StatusBadRequest, "invalid input body: "+err.Error())
+		return
+	}
+
+	venueID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp.ID = venueID
+	inp.OrganizerID = organizerID
+	inp.Role = role
+
+	venue, err := h.services.Venues.UpdateVenue(c.Request.Context(), &inp)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidTimezone) ||
+			errors.Is(err, domainErrors.ErrVenueCapacityExceeded) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error updating venue: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, venue)
+}
+
+// @Summary Delete Venue
+// @Tags venues
+// @Description Delete a venue that no event takes place at
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} helpers.Response
+// @Failure 400 {object} helpers.Response
+// @Failure 401 {object} helpers.Response
+// @Failure 403 {object} helpers.Response
+// @Failure 404 {object} helpers.Response
+// @Failure 500 {object} helpers.Response
+// @Router /api/v1/venues/{id} [delete]
+func (h *Handler) deleteVenue(c *gin.Context) {
+	venueID, err := h.validateRequestIDParam(c, values.IdQueryParam)
+	if err != nil {
+		return
+	}
+	organizerID, err := h.validateContextIDKey(c, values.UserIdCtx)
+	if err != nil {
+		return
+	}
+	role, err := h.validateContextKey(c, values.RoleCtx)
+	if err != nil {
+		logrus.Warn("Error getting role from context")
+		return
+	}
+
+	inp := requests.DeleteVenueRequest{
+		ID:          venueID,
+		OrganizerID: organizerID,
+		Role:        role,
+	}
+
+	if err := h.services.Venues.DeleteVenue(c.Request.Context(), &inp); err != nil {
+		if errors.Is(err, domainErrors.ErrVenueInUse) {
+			helpers.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domainErrors.ErrVenueNotFound) {
+			helpers.NewErrorResponse(c, http.StatusNotFound, "venue not found")
+			return
+		}
+		if errors.Is(err, types.ErrNotAuthorized) {
+			helpers.NewErrorResponse(c, http.StatusForbidden, err.Error())
+			return
+		}
+		logrus.Errorf("Error deleting venue: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, helpers.NewResponse("venue deleted successfully"))
+}
@@ -1,25 +1,35 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"ticket-booking-app-backend/internal/application/service"
+	types "ticket-booking-app-backend/internal/application/types/errors"
+	domainErrors "ticket-booking-app-backend/internal/domain/types"
 	"ticket-booking-app-backend/internal/helpers"
 	"ticket-booking-app-backend/pkg/values"
 )
 
 type AuthMiddleware struct {
 	Jwt               helpers.Jwt
+	Policy            service.Policy
+	Sessions          service.Sessions
 }
 
-func NewAuthMiddleware(jwt helpers.Jwt) *AuthMiddleware {
+func NewAuthMiddleware(jwt helpers.Jwt, policy service.Policy, sessions service.Sessions) *AuthMiddleware {
 	return &AuthMiddleware{
 		Jwt:               jwt,
+		Policy:            policy,
+		Sessions:          sessions,
 	}
 }
 
-// UserIdentity middleware checks if the user is authenticated and sets the user's id in the context
+// UserIdentity middleware checks if the user is authenticated and sets the user's id in the context,
+// tokens of sessions that were signed out are turned away
 func (m *AuthMiddleware) UserIdentity(c *gin.Context) {
 	header := c.GetHeader(values.AuthorizationHeader)
 	if header == "" {
@@ -39,13 +49,26 @@ func (m *AuthMiddleware) UserIdentity(c *gin.Context) {
 		return
 	}
 
+	err = m.Sessions.CheckSession(c.Request.Context(), userClaims.UserId, userClaims.SessionId, userClaims.TokenVersion)
+	if errors.Is(err, domainErrors.ErrSessionRevoked) {
+		helpers.NewErrorResponse(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if err != nil {
+		logrus.Errorf("Error checking session: %s", err)
+		helpers.NewErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	c.Set(values.UserIdCtx, userClaims.UserId)
 	c.Set(values.RoleCtx, userClaims.Role)
+	c.Set(values.SessionIdCtx, userClaims.SessionId)
 	c.Set(values.UserRefreshTokenCtx, header)
 }
 
-// RoleMiddleware checks if a user has the required role to access a route
-func (m *AuthMiddleware) RoleMiddleware(allowedRoles ...string) gin.HandlerFunc {
+// PermissionMiddleware checks if the user's role may take the action on the resource in the scope,
+// services narrow it down further to the resources the user may touch
+func (m *AuthMiddleware) PermissionMiddleware(resource, action, scope string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get the user's role from the context (set by UserIdentity middleware)
 		role, exists := c.Get(values.RoleCtx)
@@ -55,17 +78,19 @@ func (m *AuthMiddleware) RoleMiddleware(allowedRoles ...string) gin.HandlerFunc
 			return
 		}
 
-		// Check if the role is in the list of allowed roles
-		userRole := role.(string)
-		for _, allowedRole := range allowedRoles {
-			if userRole == allowedRole {
-				c.Next() // Role is allowed, continue
-				return
-			}
+		err := m.Policy.Authorize(c.Request.Context(), role.(string), resource, action, scope)
+		if errors.Is(err, types.ErrNotAuthorized) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this resource"})
+			c.Abort()
+			return
+		}
+		if err != nil {
+			logrus.Errorf("Error checking permissions: %s", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Abort()
+			return
 		}
 
-		// If the role is not allowed, return Forbidden
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this resource"})
-		c.Abort()
+		c.Next()
 	}
 }
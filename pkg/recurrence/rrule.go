@@ -0,0 +1,254 @@
+// Package recurrence expands the subset of RFC 5545 recurrence rules used by event series.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// maxPeriods stops the expansion of rules that never produce a date, e.g. the 31st of every other February
+const maxPeriods = 10000
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is a parsed RRULE, e.g. FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20250101T000000Z.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int            // Total occurrences including the first one, 0 for no limit
+	Until      *time.Time     // Last possible occurrence, inclusive
+	ByDay      []time.Weekday // Weekly rules only
+	ByMonthDay []int          // Monthly rules only, negative days count from the end of the month
+}
+
+// Parse reads a rule in RRULE notation, an "RRULE:" prefix is allowed.
+// FREQ, INTERVAL, COUNT, UNTIL, BYDAY and BYMONTHDAY are supported.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			switch freq := Frequency(strings.ToUpper(value)); freq {
+			case Daily, Weekly, Monthly:
+				rule.Freq = freq
+			default:
+				return nil, fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("%w: invalid interval %q", ErrInvalidRule, value)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("%w: invalid count %q", ErrInvalidRule, value)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid until %q", ErrInvalidRule, value)
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("%w: invalid weekday %q", ErrInvalidRule, day)
+				}
+				if !containsWeekday(rule.ByDay, weekday) {
+					rule.ByDay = append(rule.ByDay, weekday)
+				}
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return nil, fmt.Errorf("%w: invalid month day %q", ErrInvalidRule, day)
+				}
+				if !containsInt(rule.ByMonthDay, monthDay) {
+					rule.ByMonthDay = append(rule.ByMonthDay, monthDay)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %q", ErrInvalidRule, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL can't be combined", ErrInvalidRule)
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != Weekly {
+		return nil, fmt.Errorf("%w: BYDAY needs FREQ=WEEKLY", ErrInvalidRule)
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq != Monthly {
+		return nil, fmt.Errorf("%w: BYMONTHDAY needs FREQ=MONTHLY", ErrInvalidRule)
+	}
+	return rule, nil
+}
+
+// Between returns the occurrences of the rule starting at dtstart that fall within [from, to],
+// leaving out the exceptions. Wall clock times are kept in dtstart's location, so a weekly
+// 19:00 show stays at 19:00 across daylight saving changes. Exceptions still count towards COUNT.
+func (r *Rule) Between(dtstart, from, to time.Time, exceptions []time.Time) []time.Time {
+	var result []time.Time
+	seen := 0
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, occurrence := range r.period(dtstart, period) {
+			if occurrence.Before(dtstart) {
+				continue
+			}
+			if occurrence.After(to) || (r.Until != nil && occurrence.After(*r.Until)) {
+				return result
+			}
+
+			seen++
+			if !occurrence.Before(from) && !isException(occurrence, exceptions) {
+				result = append(result, occurrence)
+			}
+			if r.Count > 0 && seen >= r.Count {
+				return result
+			}
+		}
+	}
+	return result
+}
+
+// period returns the candidate occurrences of the n-th period after dtstart in chronological order
+func (r *Rule) period(dtstart time.Time, n int) []time.Time {
+	hour, minute, second := dtstart.Clock()
+
+	switch r.Freq {
+	case Weekly:
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{dtstart.Weekday()}
+		}
+
+		// Weeks start on Monday as in RFC 5545
+		weekStart := dtstart.AddDate(0, 0, -mondayOffset(dtstart.Weekday())+7*r.Interval*n)
+		result := make([]time.Time, 0, len(days))
+		for _, day := range days {
+			date := weekStart.AddDate(0, 0, mondayOffset(day))
+			result = append(result, time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, 0, dtstart.Location()))
+		}
+		sortTimes(result)
+		return result
+
+	case Monthly:
+		days := r.ByMonthDay
+		if len(days) == 0 {
+			days = []int{dtstart.Day()}
+		}
+
+		first := time.Date(dtstart.Year(), dtstart.Month()+time.Month(r.Interval*n), 1, hour, minute, second, 0, dtstart.Location())
+		daysInMonth := first.AddDate(0, 1, -1).Day()
+		resolved := make([]int, 0, len(days))
+		for _, day := range days {
+			if day < 0 {
+				day = daysInMonth + day + 1
+			}
+			// Days the month doesn't have are skipped, not moved
+			if day < 1 || day > daysInMonth || containsInt(resolved, day) {
+				continue
+			}
+			resolved = append(resolved, day)
+		}
+
+		result := make([]time.Time, len(resolved))
+		for i, day := range resolved {
+			result[i] = first.AddDate(0, 0, day-1)
+		}
+		sortTimes(result)
+		return result
+
+	default:
+		return []time.Time{dtstart.AddDate(0, 0, r.Interval*n)}
+	}
+}
+
+// parseUntil accepts the UTC and floating date-time forms and plain dates of RFC 5545
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, ErrInvalidRule
+}
+
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isException(occurrence time.Time, exceptions []time.Time) bool {
+	for _, exception := range exceptions {
+		if occurrence.Equal(exception) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortTimes(times []time.Time) {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+}
@@ -0,0 +1,277 @@
+package recurrence
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata" // The DST cases don't depend on the zone database of the machine
+)
+
+func utc(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	until := utc(2025, time.January, 8, 19)
+	untilDate := time.Date(2025, time.January, 8, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		rule string
+		want *Rule
+	}{
+		{"FREQ=DAILY", &Rule{Freq: Daily, Interval: 1}},
+		{"RRULE:freq=weekly;interval=2", &Rule{Freq: Weekly, Interval: 2}},
+		{"FREQ=WEEKLY;BYDAY=TU,th,TU", &Rule{Freq: Weekly, Interval: 1, ByDay: []time.Weekday{time.Tuesday, time.Thursday}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1,1;COUNT=6", &Rule{Freq: Monthly, Interval: 1, Count: 6, ByMonthDay: []int{1, -1}}},
+		{"FREQ=DAILY;UNTIL=20250108T190000Z", &Rule{Freq: Daily, Interval: 1, Until: &until}},
+		{"FREQ=DAILY;UNTIL=20250108", &Rule{Freq: Daily, Interval: 1, Until: &untilDate}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"RRULE:",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;COUNT",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;WKST=MO",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYMONTHDAY=-32",
+	}
+
+	for _, rule := range tests {
+		t.Run(rule, func(t *testing.T) {
+			if _, err := Parse(rule); !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("Parse error = %v, want %v", err, ErrInvalidRule)
+			}
+		})
+	}
+}
+
+func TestRuleBetween(t *testing.T) {
+	// Monday, a week starts on it
+	monday := utc(2025, time.January, 6, 19)
+
+	tests := []struct {
+		name       string
+		rule       string
+		dtstart    time.Time
+		from, to   time.Time
+		exceptions []time.Time
+		want       []time.Time
+	}{
+		{
+			name:    "daily",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: monday,
+			want:    []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 7, 19), utc(2025, time.January, 8, 19)},
+		},
+		{
+			name:    "daily interval",
+			rule:    "FREQ=DAILY;INTERVAL=3;COUNT=3",
+			dtstart: monday,
+			want:    []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 9, 19), utc(2025, time.January, 12, 19)},
+		},
+		{
+			name:    "weekly interval",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			dtstart: monday,
+			want:    []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 20, 19), utc(2025, time.February, 3, 19)},
+		},
+		{
+			name:    "by day",
+			rule:    "FREQ=WEEKLY;BYDAY=TH,TU;COUNT=4",
+			dtstart: monday,
+			want: []time.Time{
+				utc(2025, time.January, 7, 19), utc(2025, time.January, 9, 19),
+				utc(2025, time.January, 14, 19), utc(2025, time.January, 16, 19),
+			},
+		},
+		{
+			name:    "by day with interval keeps sunday in the week of its monday",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,MO;COUNT=4",
+			dtstart: monday,
+			want: []time.Time{
+				utc(2025, time.January, 6, 19), utc(2025, time.January, 12, 19),
+				utc(2025, time.January, 20, 19), utc(2025, time.January, 26, 19),
+			},
+		},
+		{
+			name:    "by day skips days before dtstart",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,FR;COUNT=3",
+			dtstart: utc(2025, time.January, 8, 19),
+			want:    []time.Time{utc(2025, time.January, 10, 19), utc(2025, time.January, 13, 19), utc(2025, time.January, 17, 19)},
+		},
+		{
+			name:    "monthly skips months without the day",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: utc(2025, time.January, 31, 19),
+			want:    []time.Time{utc(2025, time.January, 31, 19), utc(2025, time.March, 31, 19), utc(2025, time.May, 31, 19)},
+		},
+		{
+			name:    "last day of the month",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=4",
+			dtstart: utc(2025, time.January, 31, 19),
+			want: []time.Time{
+				utc(2025, time.January, 31, 19), utc(2025, time.February, 28, 19),
+				utc(2025, time.March, 31, 19), utc(2025, time.April, 30, 19),
+			},
+		},
+		{
+			name:    "first and last day in a leap year",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1,1;COUNT=4",
+			dtstart: utc(2024, time.January, 1, 19),
+			want: []time.Time{
+				utc(2024, time.January, 1, 19), utc(2024, time.January, 31, 19),
+				utc(2024, time.February, 1, 19), utc(2024, time.February, 29, 19),
+			},
+		},
+		{
+			name:    "negative day the month doesn't have",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-31;COUNT=2",
+			dtstart: utc(2025, time.January, 1, 19),
+			want:    []time.Time{utc(2025, time.January, 1, 19), utc(2025, time.March, 1, 19)},
+		},
+		{
+			name:    "until is inclusive",
+			rule:    "FREQ=DAILY;UNTIL=20250108T190000Z",
+			dtstart: monday,
+			want:    []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 7, 19), utc(2025, time.January, 8, 19)},
+		},
+		{
+			name:    "until date covers the whole day",
+			rule:    "FREQ=DAILY;UNTIL=20250108",
+			dtstart: monday,
+			want:    []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 7, 19), utc(2025, time.January, 8, 19)},
+		},
+		{
+			name:       "exceptions count towards count",
+			rule:       "FREQ=DAILY;COUNT=4",
+			dtstart:    monday,
+			exceptions: []time.Time{utc(2025, time.January, 7, 19), utc(2025, time.January, 20, 19)},
+			want:       []time.Time{utc(2025, time.January, 6, 19), utc(2025, time.January, 8, 19), utc(2025, time.January, 9, 19)},
+		},
+		{
+			name:    "count is taken from dtstart, not from the window",
+			rule:    "FREQ=DAILY;COUNT=5",
+			dtstart: monday,
+			from:    utc(2025, time.January, 9, 0),
+			want:    []time.Time{utc(2025, time.January, 9, 19), utc(2025, time.January, 10, 19)},
+		},
+		{
+			name:    "window of an endless rule",
+			rule:    "FREQ=DAILY",
+			dtstart: monday,
+			from:    utc(2025, time.January, 10, 19),
+			to:      utc(2025, time.January, 12, 18),
+			want:    []time.Time{utc(2025, time.January, 10, 19), utc(2025, time.January, 11, 19)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse: %s", err)
+			}
+
+			from, to := tt.from, tt.to
+			if from.IsZero() {
+				from = tt.dtstart
+			}
+			if to.IsZero() {
+				to = tt.dtstart.AddDate(1, 0, 0)
+			}
+
+			assertTimes(t, rule.Between(tt.dtstart, from, to, tt.exceptions), tt.want)
+		})
+	}
+}
+
+func TestRuleBetweenKeepsWallClockAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 19, 0, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		want    []time.Time
+	}{
+		{
+			name:    "weekly into summer time",
+			rule:    "FREQ=WEEKLY;COUNT=3",
+			dtstart: at(time.March, 23),
+			want:    []time.Time{at(time.March, 23), at(time.March, 30), at(time.April, 6)},
+		},
+		{
+			name:    "daily into winter time",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: at(time.October, 25),
+			want:    []time.Time{at(time.October, 25), at(time.October, 26), at(time.October, 27)},
+		},
+		{
+			name:    "monthly into summer time",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=2",
+			dtstart: at(time.February, 28),
+			want:    []time.Time{at(time.February, 28), at(time.March, 31)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse: %s", err)
+			}
+
+			got := rule.Between(tt.dtstart, tt.dtstart, tt.dtstart.AddDate(1, 0, 0), nil)
+			assertTimes(t, got, tt.want)
+			for _, occurrence := range got {
+				if occurrence.Hour() != 19 || occurrence.Location() != berlin {
+					t.Errorf("occurrence %s moved off 19:00 Berlin time", occurrence)
+				}
+			}
+		})
+	}
+}
+
+func assertTimes(t *testing.T, got, want []time.Time) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d occurrences %v, want %d %v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
@@ -6,6 +6,7 @@ const (
 	RoleCtx             = "role"
 	UserAccessTokenCtx  = "accessToken"
 	UserRefreshTokenCtx = "refreshToken"
+	SessionIdCtx        = "sessionId"
 )
 
 const (
@@ -14,21 +15,154 @@ const (
 	OrganizerRole = "organizer"
 )
 
+// Permission resources, a role grants actions on them, see entities.Role
 const (
-	EventStatusActive    = "active"
-	EventStatusCancelled = "cancelled"
-	EventStatusFinished  = "finished"
+	ResourceEvents        = "events"
+	ResourceEventSeries   = "event_series"
+	ResourceTicketTypes   = "ticket_types"
+	ResourceSeatMaps      = "seat_maps"
+	ResourceVenues        = "venues"
+	ResourceCategories    = "categories"
+	ResourceTickets       = "tickets"
+	ResourcePayments      = "payments"
+	ResourceRefunds       = "refunds"
+	ResourceOrganizers    = "organizers"
+	ResourceOrganizations = "organizations"
+	ResourceRoles         = "roles"
+	ResourceUsers         = "users"
+)
+
+// Permission actions
+const (
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionPublish = "publish"
+	ActionCancel  = "cancel"
+	ActionReview  = "review" // Approve or reject events and organizers
+)
+
+// Permission scopes, a permission on any resource covers the user's own too
+const (
+	ScopeOwn = "own" // What the user created or reaches through their organizations
+	ScopeAny = "any"
+
+	PermissionWildcard = "*" // Stands for every resource or every action
+)
+
+// Custom roles are read again from the database after this long
+const (
+	RoleCacheTTLSeconds = 60
+)
+
+// Revoked sessions are read again from the database after this long, other instances of the
+// app stop accepting a signed out access token within it
+const (
+	SessionCacheTTLSeconds = 30
+)
+
+// Account statuses, organizers start pending and can only sell once an admin approves them
+const (
+	UserStatusActive    = "active"
+	UserStatusPending   = "pending"
+	UserStatusRejected  = "rejected"
+	UserStatusSuspended = "suspended"
+)
+
+// Organization member roles, see entities.OrganizationRoleAllows for what each of them may do
+const (
+	OrganizationRoleOwner     = "owner"      // Manages the members, every organization has exactly one
+	OrganizationRoleManager   = "manager"    // Runs the events
+	OrganizationRoleBoxOffice = "box_office" // Handles tickets and refunds
+	OrganizationRoleViewer    = "viewer"     // Sees the events and their sales
+)
+
+// Organization permissions granted by the member roles
+const (
+	OrganizationPermissionManageMembers = "manage_members"
+	OrganizationPermissionManageEvents  = "manage_events"
+	OrganizationPermissionManageTickets = "manage_tickets"
+	OrganizationPermissionViewEvents    = "view_events"
+)
+
+// Organization invitations can be accepted for this long
+const (
+	InvitationTTLHours = 72
+)
+
+// Email verification codes, a code takes a few guesses before a new one has to be sent
+const (
+	VerificationCodeTTLMinutes        = 30
+	MaxVerificationAttempts           = 5
+	VerificationResendCooldownSeconds = 60
+)
+
+// Password reset tokens can be used once within this time
+const (
+	PasswordResetTTLMinutes = 30
+)
+
+// Event lifecycle, see entities.CanTransitionEvent for the allowed moves
+const (
+	EventStatusDraft         = "draft"          // Being set up, only its organizer sees it
+	EventStatusPendingReview = "pending_review" // Waiting for an admin when moderation is on
+	EventStatusScheduled     = "scheduled"      // Published automatically at its publish_at
+	EventStatusPublished     = "published"      // Visible and on sale
+	EventStatusOngoing       = "ongoing"
+	EventStatusCancelled     = "cancelled"
+	EventStatusFinished      = "finished"
+)
+
+// Event review history entries
+const (
+	ReviewActionSubmitted = "submitted"
+	ReviewActionApproved  = "approved"
+	ReviewActionRejected  = "rejected"
+)
+
+// Event series are materialized this far ahead
+const (
+	SeriesHorizonDays = 90
 )
 
 const (
 	StatusQueryParam = "status"
 )
 
+// Event listing sort fields and page sizes
+const (
+	EventSortDate      = "date"
+	EventSortPrice     = "price"
+	EventSortTitle     = "title"
+	EventSortCreatedAt = "created_at"
+	EventSortRelevance = "relevance" // Only meaningful when searching
+
+	DefaultEventsPageSize = 20
+	MaxEventsPageSize     = 100
+	MaxTagFacets          = 20 // Most used tags returned as facets
+)
+
+// Nearby event search, distances in kilometers
+const (
+	EarthRadiusKm         = 6371.0
+	DefaultNearbyRadiusKm = 10.0
+	MaxNearbyRadiusKm     = 500.0
+)
+
+// Event search settings
+const (
+	SearchLanguage       = "english" // Must match the search_vector column in post_migrate.sql
+	SearchHighlightStart = "<mark>"
+	SearchHighlightStop  = "</mark>"
+)
+
 const (
 	TicketStatusReserved  = "reserved"
 	TicketStatusPaid      = "paid"
 	TicketStatusCancelled = "cancelled"
 	TicketStatusExpired   = "expired"
+	TicketStatusRefunded  = "refunded"
 )
 
 const (
@@ -38,6 +172,22 @@ const (
 	PaymentStatusRefunded  = "refunded"
 )
 
+const (
+	PaymentCurrency = "usd"
+)
+
+const (
+	RefundStatusPending   = "pending"
+	RefundStatusCompleted = "completed"
+	RefundStatusFailed    = "failed"
+)
+
+const (
+	RefundReasonEventCancelled = "event cancelled"
+	RefundReasonUserRequested  = "requested by user"
+	RefundReasonReleased       = "tickets released before payment"
+)
+
 // Ticket limits and timeouts
 const (
 	MaxTicketsPerPurchase    = 5
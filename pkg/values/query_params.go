@@ -1,8 +1,12 @@
 package values
 
 const (
-	NameQueryParam    = "name"
-	IdQueryParam      = "id"
-	EventIdQueryParam = "eventId"
-	OrganizerIdCtx    = "organizerId"
+	NameQueryParam     = "name"
+	IdQueryParam       = "id"
+	EventIdQueryParam  = "eventId"
+	TypeIdQueryParam   = "typeId"
+	MemberIdQueryParam = "memberId"
+	CityQueryParam     = "city"
+	CountryQueryParam  = "country"
+	OrganizerIdCtx     = "organizerId"
 )